@@ -1,5 +1,10 @@
 package leakspok
 
+import (
+	"fmt"
+	"strings"
+)
+
 // AnonymizeStrategy defines the strategy for anonymizing a finding
 type AnonymizeStrategy int
 
@@ -10,7 +15,34 @@ const (
 	MASK
 )
 
-// AnonymizeOptions defines the options for anonymizing a finding
+// String returns the lower case name of the strategy
+func (a AnonymizeStrategy) String() string {
+	switch a {
+	case REDACT:
+		return "redact"
+	case MASK:
+		return "mask"
+	default:
+		return fmt.Sprintf("AnonymizeStrategy(%d)", int(a))
+	}
+}
+
+// ParseAnonymizeStrategy returns the strategy named s, either "redact" or "mask"
+func ParseAnonymizeStrategy(s string) (AnonymizeStrategy, error) {
+	switch strings.ToLower(s) {
+	case "redact":
+		return REDACT, nil
+	case "mask":
+		return MASK, nil
+	default:
+		return REDACT, fmt.Errorf("unknown anonymize strategy %q", s)
+	}
+}
+
+// AnonymizeOptions defines the options for anonymizing a finding.
+// With the MASK strategy, AnonymizeString is repeated over the first
+// AnonymizeLength characters of the finding, or over all of them when
+// AnonymizeLength is longer than the finding.
 type AnonymizeOptions struct {
 	Strategy        AnonymizeStrategy
 	AnonymizeString string
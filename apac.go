@@ -0,0 +1,332 @@
+package leakspok
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	aadhaarPattern  = `[2-9]\d{3} ?\d{4} ?\d{4}`
+	panPattern      = `[A-Z]{3}[ABCFGHJLPT][A-Z]\d{4}[A-Z]`
+	nricPattern     = `(?i)[STFGM]\d{7}[A-Z]`
+	tfnPattern      = `\d{3} ?\d{3} ?\d{2,3}`
+	medicarePattern = `[2-6]\d{3} ?\d{5} ?\d`
+	myNumberPattern = `\d{4} ?\d{4} ?\d{4}`
+)
+
+var (
+	aadhaarRegexp  = regexp.MustCompile(aadhaarPattern)
+	panRegexp      = regexp.MustCompile(panPattern)
+	nricRegexp     = regexp.MustCompile(nricPattern)
+	tfnRegexp      = regexp.MustCompile(tfnPattern)
+	medicareRegexp = regexp.MustCompile(medicarePattern)
+	myNumberRegexp = regexp.MustCompile(myNumberPattern)
+)
+
+// aadhaarKeywords are the words that usually come with an Aadhaar number
+var aadhaarKeywords = []string{
+	"aadhaar", "aadhar", "uid", "uidai", "आधार",
+}
+
+// tfnKeywords are the words that usually come with an Australian TFN
+var tfnKeywords = []string{
+	"tfn", "tax file number", "tax file no",
+}
+
+// medicareKeywords are the words that usually come with an Australian Medicare
+// number
+var medicareKeywords = []string{
+	"medicare", "medicare card", "medicare number", "medicare no",
+}
+
+// myNumberKeywords are the words that usually come with a Japanese My Number
+var myNumberKeywords = []string{
+	"my number", "mynumber", "個人番号", "マイナンバー",
+}
+
+var (
+	// IndiaRuleSet provides a rule set of Indian identification numbers
+	IndiaRuleSet = RuleSet{
+		"aadhaar_number": DefaultAadhaarRule,
+		"pan_number":     DefaultPANRule,
+	}
+
+	// SingaporeRuleSet provides a rule set of Singaporean identification numbers
+	SingaporeRuleSet = RuleSet{
+		"nric_number": DefaultNRICRule,
+	}
+
+	// AustraliaRuleSet provides a rule set of Australian identification numbers
+	AustraliaRuleSet = RuleSet{
+		"tfn_number":      DefaultTFNRule,
+		"medicare_number": DefaultMedicareRule,
+	}
+
+	// JapanRuleSet provides a rule set of Japanese identification numbers
+	JapanRuleSet = RuleSet{
+		"my_number": DefaultMyNumberRule,
+	}
+
+	// DefaultAadhaarRule is a default rule for Indian Aadhaar numbers
+	DefaultAadhaarRule = Rule{
+		Name:        "india_aadhaar",
+		Description: "Indian Aadhaar number",
+		Severity:    5,
+		Filter:      Aadhaar(),
+		Locate:      AadhaarLocator(),
+	}
+
+	// DefaultPANRule is a default rule for Indian PAN
+	DefaultPANRule = Rule{
+		Name:        "india_PAN",
+		Description: "Indian PAN (permanent account number)",
+		Severity:    4,
+		Filter:      PAN(),
+		Locate:      RegexpLocator(panRegexp, PAN()),
+	}
+
+	// DefaultNRICRule is a default rule for Singaporean NRIC and FIN
+	DefaultNRICRule = Rule{
+		Name:        "singapore_NRIC",
+		Description: "Singaporean NRIC/FIN",
+		Severity:    4,
+		Filter:      NRIC(),
+		Locate:      RegexpLocator(nricRegexp, NRIC()),
+	}
+
+	// DefaultTFNRule is a default rule for Australian TFN
+	DefaultTFNRule = Rule{
+		Name:        "australia_TFN",
+		Description: "Australian TFN (tax file number)",
+		Severity:    5,
+		Filter:      TFN(),
+		Locate:      TFNLocator(),
+	}
+
+	// DefaultMedicareRule is a default rule for Australian Medicare numbers
+	DefaultMedicareRule = Rule{
+		Name:        "australia_medicare",
+		Description: "Australian Medicare number",
+		Severity:    4,
+		Filter:      Medicare(),
+		Locate:      MedicareLocator(),
+	}
+
+	// DefaultMyNumberRule is a default rule for Japanese My Number
+	DefaultMyNumberRule = Rule{
+		Name:        "japan_my_number",
+		Description: "Japanese My Number (individual number)",
+		Severity:    5,
+		Filter:      MyNumber(),
+		Locate:      MyNumberLocator(),
+	}
+)
+
+// Aadhaar generates a matcher for identifying Indian Aadhaar numbers,
+// validating their Verhoeff check digit
+func Aadhaar() Matcher {
+	return Any(
+		matchAadhaar,
+	)
+}
+
+// AadhaarLocator generates a locator for Aadhaar numbers. Numbers grouped as
+// in "2345 6789 0124" are always found, and unformatted ones only after or
+// before a keyword such as "Aadhaar".
+func AadhaarLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(aadhaarRegexp, And(Aadhaar(), Not(isNumeric))),
+		WithContext(RegexpLocator(aadhaarRegexp, Aadhaar()), 40, aadhaarKeywords...),
+	)
+}
+
+// PAN generates a matcher for identifying Indian PANs, validating their entity
+// type
+func PAN() Matcher {
+	return Any(
+		matchPAN,
+	)
+}
+
+// NRIC generates a matcher for identifying Singaporean NRICs and FINs,
+// validating their check letter
+func NRIC() Matcher {
+	return Any(
+		matchNRIC,
+	)
+}
+
+// TFN generates a matcher for identifying Australian TFNs, validating their
+// check digit
+func TFN() Matcher {
+	return Any(
+		matchTFN,
+	)
+}
+
+// TFNLocator generates a locator for Australian TFNs that come after or
+// before a keyword such as "TFN"
+func TFNLocator() Locator {
+	return WithContext(RegexpLocator(tfnRegexp, TFN()), 40, tfnKeywords...)
+}
+
+// Medicare generates a matcher for identifying Australian Medicare numbers,
+// validating their check digit
+func Medicare() Matcher {
+	return Any(
+		matchMedicare,
+	)
+}
+
+// MedicareLocator generates a locator for Australian Medicare numbers that
+// come after or before a keyword such as "Medicare"
+func MedicareLocator() Locator {
+	return WithContext(RegexpLocator(medicareRegexp, Medicare()), 40, medicareKeywords...)
+}
+
+// MyNumber generates a matcher for identifying Japanese My Numbers, validating
+// their check digit
+func MyNumber() Matcher {
+	return Any(
+		matchMyNumber,
+	)
+}
+
+// MyNumberLocator generates a locator for Japanese My Numbers that come after
+// or before a keyword such as "個人番号"
+func MyNumberLocator() Locator {
+	return WithContext(RegexpLocator(myNumberRegexp, MyNumber()), 40, myNumberKeywords...)
+}
+
+// matchAadhaar returns an Indian Aadhaar match with 12 digits, optionally
+// grouped by four. Numbers never start with 0 or 1, and the last digit is a
+// Verhoeff checksum.
+func matchAadhaar(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(aadhaarRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	return verhoeff(s)
+}
+
+// matchPAN returns an Indian PAN match in the "ABCPE1234F" format. The fourth
+// letter is the type of the holder: P for people, C for companies, H for Hindu
+// undivided families, F for firms, A for associations of persons, T for
+// trusts, B for bodies of individuals, L for local authorities, J for
+// artificial juridical persons and G for governments.
+func matchPAN(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	return fullMatch(panRegexp, s)
+}
+
+// nricLetters are the check letters of NRICs and FINs, indexed by the
+// weighted sum modulo 11
+var nricLetters = map[byte]string{
+	'S': "JZIHGFEDCBA",
+	'T': "JZIHGFEDCBA",
+	'F': "XWUTRQPNMLK",
+	'G': "XWUTRQPNMLK",
+	'M': "XWUTRQPNJLK",
+}
+
+// nricOffsets are added to the weighted sum of NRICs and FINs by their prefix
+var nricOffsets = map[byte]int{'T': 4, 'G': 4, 'M': 3}
+
+// matchNRIC returns a Singaporean NRIC or FIN match in the "S1234567D" format.
+// The digits are weighted by 2, 7, 6, 5, 4, 3 and 2, and the check letter
+// depends on their sum modulo 11 and on the prefix: S and T for citizens and
+// permanent residents, F, G and M for foreigners.
+func matchNRIC(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(nricRegexp, s) {
+		return false
+	}
+	s = strings.ToUpper(s)
+
+	sum := sumDigit(s[1:8], []int{2, 7, 6, 5, 4, 3, 2}) + nricOffsets[s[0]]
+	return s[8] == nricLetters[s[0]][sum%11]
+}
+
+// matchTFN returns an Australian TFN match with 9 digits, or 8 for older
+// numbers, optionally grouped by three. The digits are weighted by 1, 4, 3, 7,
+// 5, 8, 6, 9 and 10, and their sum must be a multiple of 11.
+func matchTFN(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(tfnRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	weights := []int{1, 4, 3, 7, 5, 8, 6, 9, 10}
+	if len(s) == 8 {
+		weights = []int{10, 7, 8, 4, 6, 3, 5, 1}
+	}
+
+	return sumDigit(s, weights)%11 == 0
+}
+
+// matchMedicare returns an Australian Medicare match with 10 digits, optionally
+// grouped as in "2123 45670 1". The first digit is 2 to 6, the ninth is the
+// weighted sum of the first eight modulo 10, and the last one is the issue
+// number of the card.
+func matchMedicare(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(medicareRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	if s[9] == '0' {
+		return false
+	}
+
+	checkDigit := sumDigit(s[:8], []int{1, 3, 7, 9, 1, 3, 7, 9}) % 10
+	return int(s[8]-'0') == checkDigit
+}
+
+// matchMyNumber returns a Japanese My Number match with 12 digits, optionally
+// grouped by four. The digits are weighted from the right by 2 to 7 and then
+// 2 to 6, and the check digit is 11 minus their sum modulo 11, or 0 when that
+// sum is 0 or 1.
+func matchMyNumber(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(myNumberRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	checkDigit := sumDigit(s[:11], []int{6, 5, 4, 3, 2, 7, 6, 5, 4, 3, 2}) % 11
+	if checkDigit <= 1 {
+		checkDigit = 0
+	} else {
+		checkDigit = 11 - checkDigit
+	}
+
+	return int(s[11]-'0') == checkDigit
+}
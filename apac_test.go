@@ -0,0 +1,165 @@
+package leakspok
+
+import "testing"
+
+func TestVerhoeff(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"2363", true},
+		{"234567890124", true},
+		{"2364", false},
+		{"2336", false},
+		{"23a3", false},
+	}
+
+	for _, test := range tests {
+		if got := verhoeff(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchAadhaar(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"234567890124", true},
+		{"4918 3726 5018", true},
+		{"876543210988", true},
+		{"234567890125", false},
+		{"134567890124", false},
+		{"034567890124", false},
+		{"222222222222", false},
+	}
+
+	for _, test := range tests {
+		if got := matchAadhaar(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchPAN(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"ABCPE1234F", true},
+		{"AAACB1234C", true},
+		{"ABCDE1234F", false},
+		{"ABCPE12345", false},
+		{"abcpe1234f", false},
+	}
+
+	for _, test := range tests {
+		if got := matchPAN(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchNRIC(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"S1234567D", true},
+		{"T0123456G", true},
+		{"F1234567N", true},
+		{"G7654321L", true},
+		{"M1234567K", true},
+		{"S1234567A", false},
+		{"A1234567D", false},
+	}
+
+	for _, test := range tests {
+		if got := matchNRIC(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchTFN(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"123456782", true},
+		{"876 543 210", true},
+		{"459599230", true},
+		{"123456789", false},
+		{"000000000", false},
+	}
+
+	for _, test := range tests {
+		if got := matchTFN(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchMedicare(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"2123456701", true},
+		{"2950 12348 1", true},
+		{"6123456742", true},
+		{"2123456711", false},
+		{"2123456700", false},
+		{"7123456701", false},
+	}
+
+	for _, test := range tests {
+		if got := matchMedicare(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchMyNumber(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"123456789018", true},
+		{"9876 5432 1093", true},
+		{"314159265352", true},
+		{"123456789017", false},
+		{"111111111111", false},
+	}
+
+	for _, test := range tests {
+		if got := matchMyNumber(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestAPACLocators(t *testing.T) {
+	tests := []struct {
+		locator Locator
+		input   string
+		expect  []string
+	}{
+		{AadhaarLocator(), "id 2345 6789 0124", []string{"2345 6789 0124"}},
+		{AadhaarLocator(), "Aadhaar: 234567890124", []string{"234567890124"}},
+		{AadhaarLocator(), "order 234567890124", nil},
+		{TFNLocator(), "TFN 123 456 782", []string{"123 456 782"}},
+		{TFNLocator(), "invoice 123456782", nil},
+		{MedicareLocator(), "Medicare card 2123 45670 1", []string{"2123 45670 1"}},
+		{MedicareLocator(), "ref 2123456701", nil},
+		{MyNumberLocator(), "個人番号: 1234 5678 9018", []string{"1234 5678 9018"}},
+		{MyNumberLocator(), "注文 123456789018", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(test.locator, test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
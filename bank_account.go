@@ -0,0 +1,118 @@
+package leakspok
+
+import (
+	"regexp"
+	"strings"
+)
+
+const bankAccountPattern = `(?i)\bag(?:ência|encia|\.)?\s*[:nº°.]*\s*(\d{4})(?:-([\dxp]))?[\s,;/|-]*` +
+	`(?:c/c|c\.c\.|cc|conta(?:[ -]corrente| poupança| poupanca)?)\s*[:nº°.]*\s*(\d{3}\.\d{8}|\d{1,12})-([\dxp])\b`
+
+var bankAccountRegexp = regexp.MustCompile(bankAccountPattern)
+
+// brazilianBank describes how a Brazilian bank computes the check digits of
+// its agencies and accounts, and the words identifying it: its COMPE code, its
+// ISPB code and its name
+type brazilianBank struct {
+	name     string
+	keywords []string
+	validate func(agency, agencyDigit, account, accountDigit string) bool
+}
+
+// brazilianBanks are the banks whose check digits are validated
+var brazilianBanks = []brazilianBank{
+	{"Banco do Brasil", []string{"001", "00000000", "banco do brasil", "bb"}, validateBancoDoBrasil},
+	{"Bradesco", []string{"237", "60746948", "bradesco"}, validateBradesco},
+	{"Itaú", []string{"341", "60701190", "itaú", "itau"}, validateItau},
+	{"Caixa", []string{"104", "00360305", "caixa", "cef"}, validateCaixa},
+}
+
+// bankAccountBanks returns the names of the banks validating the agency and
+// account of a text such as "ag 1234-3 cc 12345-5"
+func bankAccountBanks(s string) []string {
+	groups := bankAccountRegexp.FindStringSubmatch(s)
+	if groups == nil {
+		return nil
+	}
+	agency, agencyDigit := groups[1], strings.ToUpper(groups[2])
+	account, accountDigit := strings.ReplaceAll(groups[3], ".", ""), strings.ToUpper(groups[4])
+
+	var banks []string
+	for _, bank := range brazilianBanks {
+		if bank.validate(agency, agencyDigit, account, accountDigit) {
+			banks = append(banks, bank.name)
+		}
+	}
+	return banks
+}
+
+// matchBrazilianBankAccount returns a Brazilian agency and account match
+// whose check digits are valid for at least one bank
+func matchBrazilianBankAccount(s string) bool {
+	return len(bankAccountBanks(s)) > 0
+}
+
+// validateBancoDoBrasil validates the mod-11 check digits of Banco do Brasil,
+// where 10 is written as X
+func validateBancoDoBrasil(agency, agencyDigit, account, accountDigit string) bool {
+	if len(account) > 8 {
+		return false
+	}
+	if agencyDigit != "" && bankMod11(agency, []int{5, 4, 3, 2}, "X") != agencyDigit {
+		return false
+	}
+	account = strings.Repeat("0", 8-len(account)) + account
+	return bankMod11(account, []int{9, 8, 7, 6, 5, 4, 3, 2}, "X") == accountDigit
+}
+
+// validateBradesco validates the mod-11 check digits of Bradesco, where 10 is
+// written as P
+func validateBradesco(agency, agencyDigit, account, accountDigit string) bool {
+	if len(account) > 7 {
+		return false
+	}
+	if agencyDigit != "" && bankMod11(agency, []int{5, 4, 3, 2}, "P") != agencyDigit {
+		return false
+	}
+	account = strings.Repeat("0", 7-len(account)) + account
+	return bankMod11(account, []int{2, 7, 6, 5, 4, 3, 2}, "P") == accountDigit
+}
+
+// validateItau validates the check digit of Itaú accounts, computed by mod-10
+// over the agency and the five digits of the account. Itaú agencies have no
+// check digit.
+func validateItau(agency, agencyDigit, account, accountDigit string) bool {
+	if agencyDigit != "" || len(account) != 5 {
+		return false
+	}
+	return string(rune('0'+boletoMod10(agency+account))) == accountDigit
+}
+
+// validateCaixa validates the check digit of Caixa accounts, computed by
+// mod-11 over the agency, the three digits of the operation and the eight
+// digits of the account. Caixa agencies have no check digit.
+func validateCaixa(agency, agencyDigit, account, accountDigit string) bool {
+	if agencyDigit != "" || len(account) != 11 {
+		return false
+	}
+	sum := sumDigit(agency+account, []int{8, 7, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})
+	checkDigit := sum * 10 % 11
+	if checkDigit == 10 {
+		checkDigit = 0
+	}
+	return string(rune('0'+checkDigit)) == accountDigit
+}
+
+// bankMod11 returns the check digit of digits weighted by weights, as 11 minus
+// their sum modulo 11: 11 becomes 0 and 10 becomes ten
+func bankMod11(digits string, weights []int, ten string) string {
+	checkDigit := 11 - sumDigit(digits, weights)%11
+	switch checkDigit {
+	case 11:
+		return "0"
+	case 10:
+		return ten
+	default:
+		return string(rune('0' + checkDigit))
+	}
+}
@@ -0,0 +1,55 @@
+package leakspok
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBankAccountBanks(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"ag 0452-9 cc 98765432-2", "Banco do Brasil"},
+		{"agência 1234-3, conta corrente 12345-5", "Banco do Brasil,Bradesco"},
+		{"Ag. 0001-9 C/C 1234567-4", "Bradesco"},
+		{"agencia: 1234 conta: 12345-1", "Itaú"},
+		{"ag 0001 cc 54321-4", "Itaú"},
+		{"ag 1234 conta 001.00012345-9", "Caixa"},
+		{"agência 0647 conta poupança 013.00054321-7", "Caixa"},
+		{"ag 0452-8 cc 98765432-2", ""},
+		{"ag 0452-9 cc 98765432-3", ""},
+		{"ag 1234 cc 12345-2", ""},
+		{"cc 12345-5", ""},
+	}
+
+	for _, test := range tests {
+		got := strings.Join(bankAccountBanks(test.input), ",")
+		if got != test.expect {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestDescribeBrazilianBank(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"ag 1234 cc 12345-1", "Itaú"},
+		{"ag 1234-3 cc 12345-5", "Banco do Brasil,Bradesco"},
+		{"Bradesco (237) ag 1234-3 cc 12345-5", "Bradesco"},
+		{"ag 1234-3 cc 12345-5 no BB", "Banco do Brasil"},
+		{"banco 341, ag 1234-3 cc 12345-5", "Banco do Brasil,Bradesco"},
+	}
+
+	for _, test := range tests {
+		locs := BrazilianBankAccountLocator()(test.input)
+		if len(locs) != 1 {
+			t.Fatalf("For input %q expected a single match but got %d", test.input, len(locs))
+		}
+		if got := DescribeBrazilianBank()(test.input, locs[0])["bank"]; got != test.expect {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/New-Horizons-Team/leakspok"
+)
+
+// gitRecordMarker prefixes the commit header lines of the log, so they can't
+// be confused with the lines of a diff
+const gitRecordMarker = "\x1e"
+
+// gitLogFormat prints the hash and the author of each commit before its diff
+const gitLogFormat = gitRecordMarker + "commit %H%n" + gitRecordMarker + "author %an <%ae>"
+
+func runGit(args []string, stdout, stderr io.Writer) int {
+	fset := flag.NewFlagSet("git", flag.ContinueOnError)
+	fset.SetOutput(stderr)
+	fset.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: leakspok git [flags] [revision range ...]")
+		fmt.Fprintln(stderr, "Scans the lines added by each commit, by default the whole history of HEAD.")
+		fmt.Fprintln(stderr, "For example, \"origin/main..HEAD\" scans only the commits about to be pushed.")
+		fset.PrintDefaults()
+	}
+
+	var flags reportFlags
+	flags.register(fset)
+	repo := fset.String("repo", ".", "path of the local git repository")
+	all := fset.Bool("all", false, "scan the commits of all refs instead of a revision range")
+
+	if err := fset.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitError
+	}
+
+	tester, sink, err := flags.setup(stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "leakspok: %v\n", err)
+		return exitError
+	}
+
+	revisions := fset.Args()
+	if *all {
+		revisions = append([]string{"--all"}, revisions...)
+	}
+
+	if err := scanGitRepository(*repo, revisions, tester, &flags, sink.report); err != nil {
+		fmt.Fprintf(stderr, "leakspok: %v\n", err)
+		return exitError
+	}
+
+	return sink.close(stderr)
+}
+
+// scanGitRepository runs "git log" on the repository and reports the
+// findings within the lines added by each commit of the revision range
+func scanGitRepository(repo string, revisions []string, tester *leakspok.StringTester, flags *reportFlags,
+	report func(leakspok.Finding)) error {
+	args := []string{
+		"-C", repo,
+		"-c", "core.quotePath=false",
+		"log", "--no-color", "--no-ext-diff", "--no-renames", "--unified=0",
+		"--format=" + gitLogFormat, "-p",
+	}
+	args = append(args, revisions...)
+	// Keep revisions apart from paths, so a missing revision is an error
+	args = append(args, "--")
+
+	cmd := exec.Command("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	parseErr := scanGitLog(out, tester, flags, report)
+	// Drain the output so git isn't blocked writing to the pipe
+	_, _ = io.Copy(io.Discard, out)
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("git log: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return parseErr
+}
+
+// gitLogParser keeps track of where the lines of a "git log -p" output belong
+type gitLogParser struct {
+	commit string
+	author string
+	file   string
+	line   int
+	inHunk bool
+}
+
+// gitAddedBlock holds consecutive lines added by a commit, so findings that
+// span several lines, such as private keys and MRZs, can be found
+type gitAddedBlock struct {
+	commit string
+	author string
+	file   string
+	start  int
+	lines  []string
+}
+
+// scanGitLog parses the output of "git log -p --unified=0" and reports the
+// findings within every block of consecutive lines added to the files selected
+// by flags
+func scanGitLog(r io.Reader, tester *leakspok.StringTester, flags *reportFlags, report func(leakspok.Finding)) error {
+	var p gitLogParser
+	var block gitAddedBlock
+
+	flush := func() {
+		if len(block.lines) > 0 && block.file != "" && flags.selected(block.file) {
+			for _, f := range tester.FindAll(strings.Join(block.lines, "\n")) {
+				f.File, f.Line = block.file, block.start+f.Line-1
+				f.Commit, f.Author = block.commit, block.author
+				report(f)
+			}
+		}
+		block = gitAddedBlock{}
+	}
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			added, lineNumber, ok := p.parse(strings.TrimSuffix(line, "\n"))
+			switch {
+			case ok && len(block.lines) > 0 && lineNumber == block.start+len(block.lines):
+				block.lines = append(block.lines, added)
+			case ok:
+				flush()
+				block = gitAddedBlock{commit: p.commit, author: p.author, file: p.file, start: lineNumber,
+					lines: []string{added}}
+			default:
+				flush()
+			}
+		}
+
+		if err == io.EOF {
+			flush()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parse consumes a single line of the log, returning the content and the line
+// number of the line when it was added by the current commit
+func (p *gitLogParser) parse(line string) (string, int, bool) {
+	switch {
+	case strings.HasPrefix(line, gitRecordMarker+"commit "):
+		p.commit = strings.TrimPrefix(line, gitRecordMarker+"commit ")
+		p.file, p.inHunk = "", false
+	case strings.HasPrefix(line, gitRecordMarker+"author "):
+		p.author = strings.TrimPrefix(line, gitRecordMarker+"author ")
+	case strings.HasPrefix(line, "diff --git "):
+		p.file, p.inHunk = "", false
+	case !p.inHunk && strings.HasPrefix(line, "+++ "):
+		p.file = gitDiffPath(strings.TrimPrefix(line, "+++ "))
+	case strings.HasPrefix(line, "@@ "):
+		p.line, p.inHunk = gitHunkStart(line), true
+	case p.inHunk && strings.HasPrefix(line, "+"):
+		p.line++
+		return line[1:], p.line - 1, true
+	case p.inHunk && strings.HasPrefix(line, " "):
+		p.line++
+	}
+	return "", 0, false
+}
+
+// gitDiffPath returns the path of a "+++" diff header, or an empty string
+// when the file was deleted
+func gitDiffPath(path string) string {
+	if strings.HasPrefix(path, `"`) {
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			path = unquoted
+		}
+	}
+	if path == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(path, "b/")
+}
+
+// gitHunkStart returns the first line of the new file within a hunk header
+// such as "@@ -10,2 +12,3 @@"
+func gitHunkStart(header string) int {
+	fields := strings.Fields(header)
+	if len(fields) < 3 || !strings.HasPrefix(fields[2], "+") {
+		return 0
+	}
+	start := strings.TrimPrefix(fields[2], "+")
+	if i := strings.IndexByte(start, ','); i >= 0 {
+		start = start[:i]
+	}
+	n, _ := strconv.Atoi(start)
+	return n
+}
@@ -0,0 +1,108 @@
+// Command leakspok scans files and directories for PII leaks and redacts
+// PII from text streams.
+//
+// Usage:
+//
+//	leakspok scan [flags] [path ...]
+//	leakspok redact [flags] [file ...]
+//	leakspok git [flags] [revision range ...]
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/New-Horizons-Team/leakspok"
+)
+
+// Exit codes returned by the command
+const (
+	exitOK       = 0
+	exitFindings = 1
+	exitError    = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run dispatches the subcommand in args and returns the process exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitError
+	}
+
+	switch args[0] {
+	case "scan":
+		return runScan(args[1:], stdout, stderr)
+	case "redact":
+		return runRedact(args[1:], stdin, stdout, stderr)
+	case "git":
+		return runGit(args[1:], stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return exitOK
+	default:
+		fmt.Fprintf(stderr, "leakspok: unknown command %q\n", args[0])
+		usage(stderr)
+		return exitError
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprint(w, `Usage: leakspok <command> [flags]
+
+Commands:
+  scan    scan files and directories for PII
+  redact  write stdin, or files, to stdout with PII anonymized
+  git     scan the lines added by the commits of a git repository
+
+Run "leakspok <command> -h" for the flags of a command.
+`)
+}
+
+// loadTester builds a StringTester from a rule file, when given, or from the
+// comma separated list of built-in rule sets
+func loadTester(rulesFile, ruleSetNames string) (*leakspok.StringTester, error) {
+	if rulesFile != "" {
+		f, err := os.Open(rulesFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		set, err := leakspok.LoadRuleSet(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", rulesFile, err)
+		}
+		return leakspok.NewStringTester(set), nil
+	}
+
+	// Rule sets may share rules, so keep a single copy of each one
+	combined := leakspok.RuleSet{}
+	for _, name := range strings.Split(ruleSetNames, ",") {
+		set, err := leakspok.LookupRuleSet(strings.TrimSpace(name))
+		if err != nil {
+			return nil, fmt.Errorf("%w (available: %s)", err, strings.Join(leakspok.RuleSetNames(), ", "))
+		}
+		for _, rule := range set {
+			combined[rule.Name] = rule
+		}
+	}
+	return leakspok.NewStringTester(combined), nil
+}
+
+// stringList is a flag.Value collecting repeated flags
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/New-Horizons-Team/leakspok"
+)
+
+func runRedact(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fset := flag.NewFlagSet("redact", flag.ContinueOnError)
+	fset.SetOutput(stderr)
+	fset.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: leakspok redact [flags] [file ...]")
+		fmt.Fprintln(stderr, "Reads stdin when no file, or \"-\", is given.")
+		fset.PrintDefaults()
+	}
+
+	rulesFile := fset.String("rules", "", "JSON rule file to load instead of the built-in rule sets")
+	ruleSetNames := fset.String("ruleset", "default", "comma separated built-in rule sets")
+	strategyName := fset.String("strategy", "redact", "anonymize strategy: redact or mask")
+	placeholder := fset.String("placeholder", leakspok.DefaultRedactString,
+		"replacement used by the redact strategy; {rule} expands to the rule name")
+	maskChar := fset.String("mask-char", "*", "character repeated by the mask strategy")
+	maskLength := fset.Int("mask-length", 0, "number of characters masked, 0 masks the whole finding")
+
+	if err := fset.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitError
+	}
+
+	strategy, err := leakspok.ParseAnonymizeStrategy(*strategyName)
+	if err != nil {
+		fmt.Fprintf(stderr, "leakspok: %v\n", err)
+		return exitError
+	}
+
+	tester, err := loadTester(*rulesFile, *ruleSetNames)
+	if err != nil {
+		fmt.Fprintf(stderr, "leakspok: %v\n", err)
+		return exitError
+	}
+
+	// Rules that already anonymize, such as the ones configured in a rule
+	// file, keep their own options. All the others use the flags.
+	for i, rule := range tester.Rules {
+		if rule.Anonymize {
+			continue
+		}
+		opts := leakspok.AnonymizeOptions{Strategy: strategy}
+		switch strategy {
+		case leakspok.MASK:
+			opts.AnonymizeString = *maskChar
+			opts.AnonymizeLength = *maskLength
+			if opts.AnonymizeLength <= 0 {
+				// Masking is limited to the length of each finding
+				opts.AnonymizeLength = math.MaxInt
+			}
+		default:
+			opts.AnonymizeString = strings.ReplaceAll(*placeholder, "{rule}", rule.Name)
+		}
+		tester.Rules[i].Anonymize = true
+		tester.Rules[i].AnonymizeOptions = opts
+	}
+
+	files := fset.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	for _, name := range files {
+		if err := redactFile(tester, name, stdin, stdout); err != nil {
+			fmt.Fprintf(stderr, "leakspok: %v\n", err)
+			return exitError
+		}
+	}
+
+	return exitOK
+}
+
+// redactFile streams the anonymized content of the named file, or of stdin
+// when name is "-", to w
+func redactFile(tester *leakspok.StringTester, name string, stdin io.Reader, w io.Writer) error {
+	if name == "-" {
+		return tester.AnonymizeStream(stdin, w)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return tester.AnonymizeStream(f, w)
+}
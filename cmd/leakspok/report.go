@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/New-Horizons-Team/leakspok"
+)
+
+// reportFlags holds the flags shared by the commands reporting findings
+type reportFlags struct {
+	rulesFile    string
+	ruleSetNames string
+	minSeverity  int
+	failSeverity int
+	format       string
+	showMatches  bool
+	color        string
+	include      stringList
+	exclude      stringList
+}
+
+// register defines the flags on fset
+func (f *reportFlags) register(fset *flag.FlagSet) {
+	fset.StringVar(&f.rulesFile, "rules", "", "JSON rule file to load instead of the built-in rule sets")
+	fset.StringVar(&f.ruleSetNames, "ruleset", "default", "comma separated built-in rule sets")
+	fset.IntVar(&f.minSeverity, "min-severity", 0, "only report findings with at least this severity")
+	fset.IntVar(&f.failSeverity, "fail-severity", 1, "exit with status 1 when a finding has at least this severity")
+	fset.StringVar(&f.format, "format", "text", "output format: "+strings.Join(leakspok.ReportFormats(), ", "))
+	fset.BoolVar(&f.showMatches, "show-matches", false, "write findings unmasked in the report")
+	fset.StringVar(&f.color, "color", "auto", "color the table format: auto, always or never")
+	fset.Var(&f.include, "include", "only scan files matching this glob (repeatable)")
+	fset.Var(&f.exclude, "exclude", "skip files and directories matching this glob (repeatable)")
+}
+
+// selected reports whether the slash separated path passes the include and
+// exclude globs
+func (f *reportFlags) selected(path string) bool {
+	if matchesAny(f.exclude, path) {
+		return false
+	}
+	return len(f.include) == 0 || matchesAny(f.include, path)
+}
+
+// setup loads the rules and opens the reporter selected by the flags
+func (f *reportFlags) setup(stdout io.Writer) (*leakspok.StringTester, *findingSink, error) {
+	useColor, err := colorMode(f.color, stdout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := leakspok.ReportOptions{ShowMatches: f.showMatches, Color: useColor}
+	reporter, err := leakspok.NewReporter(f.format, stdout, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tester, err := loadTester(f.rulesFile, f.ruleSetNames)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sink := &findingSink{
+		reporter:     reporter,
+		minSeverity:  f.minSeverity,
+		failSeverity: f.failSeverity,
+		status:       exitOK,
+	}
+	return tester, sink, nil
+}
+
+// findingSink filters findings by severity before writing them to a
+// reporter, keeping track of the exit status
+type findingSink struct {
+	reporter     leakspok.Reporter
+	minSeverity  int
+	failSeverity int
+	status       int
+	err          error
+}
+
+// report writes a finding unless its severity is below the minimum
+func (s *findingSink) report(f leakspok.Finding) {
+	if f.Rule.Severity < s.minSeverity || s.err != nil {
+		return
+	}
+	if f.Rule.Severity >= s.failSeverity {
+		s.status = exitFindings
+	}
+	s.err = s.reporter.Report(f)
+}
+
+// close closes the reporter and returns the exit status of the command
+func (s *findingSink) close(stderr io.Writer) int {
+	if err := s.reporter.Close(); s.err == nil {
+		s.err = err
+	}
+	if s.err != nil {
+		fmt.Fprintf(stderr, "leakspok: %v\n", s.err)
+		return exitError
+	}
+	return s.status
+}
+
+// colorMode reports whether colors are enabled on w for the given mode
+func colorMode(mode string, w io.Writer) (bool, error) {
+	switch mode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		return isTerminal(w), nil
+	default:
+		return false, fmt.Errorf("unknown color mode %q", mode)
+	}
+}
+
+// isTerminal reports whether w is a terminal, to enable colors automatically
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
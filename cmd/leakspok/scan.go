@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/New-Horizons-Team/leakspok"
+)
+
+// binarySniffLen is how many leading bytes are inspected to detect binary files
+const binarySniffLen = 8000
+
+// scanner walks files and directories reporting the findings of a StringTester
+type scanner struct {
+	tester  *leakspok.StringTester
+	flags   *reportFlags
+	maxSize int64
+	stderr  io.Writer
+}
+
+func runScan(args []string, stdout, stderr io.Writer) int {
+	fset := flag.NewFlagSet("scan", flag.ContinueOnError)
+	fset.SetOutput(stderr)
+	fset.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: leakspok scan [flags] [path ...]")
+		fset.PrintDefaults()
+	}
+
+	var flags reportFlags
+	flags.register(fset)
+	maxSize := fset.Int64("max-size", 10<<20, "skip files larger than this many bytes")
+
+	if err := fset.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitError
+	}
+
+	tester, sink, err := flags.setup(stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "leakspok: %v\n", err)
+		return exitError
+	}
+
+	s := &scanner{
+		tester:  tester,
+		flags:   &flags,
+		maxSize: *maxSize,
+		stderr:  stderr,
+	}
+
+	paths := fset.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	for _, path := range paths {
+		if err := s.walk(path, sink.report); err != nil {
+			fmt.Fprintf(stderr, "leakspok: %v\n", err)
+			return exitError
+		}
+	}
+
+	return sink.close(stderr)
+}
+
+// walk scans root, recursing into directories, and calls report for every finding
+func (s *scanner) walk(root string, report func(leakspok.Finding)) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil || rel == "." {
+			rel = filepath.Base(path)
+		}
+
+		if d.IsDir() {
+			if path != root && (d.Name() == ".git" || matchesAny(s.flags.exclude, rel)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !d.Type().IsRegular() || !s.flags.selected(rel) {
+			return nil
+		}
+
+		findings, err := s.scanFile(path)
+		if err != nil {
+			// Unreadable files are reported but do not stop the scan
+			fmt.Fprintf(s.stderr, "leakspok: %v\n", err)
+			return nil
+		}
+		for _, f := range findings {
+			report(f)
+		}
+		return nil
+	})
+}
+
+// scanFile returns the findings within a single file. Binary files and
+// files larger than maxSize are skipped.
+func (s *scanner) scanFile(path string) ([]leakspok.Finding, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if s.maxSize > 0 && info.Size() > s.maxSize {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if isBinary(data) {
+		return nil, nil
+	}
+
+	findings := s.tester.FindAll(string(data))
+	for i := range findings {
+		findings[i].File = path
+	}
+	return findings, nil
+}
+
+// isBinary reports whether data looks like a binary file, using the same
+// heuristic as git: a NUL byte within the first bytes of the file
+func isBinary(data []byte) bool {
+	if len(data) > binarySniffLen {
+		data = data[:binarySniffLen]
+	}
+	return bytes.IndexByte(data, 0) >= 0
+}
+
+// matchesAny reports whether the slash separated path, or its base name,
+// matches any of the glob patterns
+func matchesAny(patterns []string, path string) bool {
+	path = filepath.ToSlash(path)
+	base := filepath.Base(path)
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, path); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, base); ok {
+			return true
+		}
+	}
+	return false
+}
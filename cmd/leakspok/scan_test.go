@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates the files under dir, creating parent directories as needed
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRunScan(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"clean.txt":           "nothing to see here\n",
+		"users.txt":           "name: joao\ncpf: 111.444.777-35\n",
+		"binary.dat":          "\x00\x01 111.444.777-35",
+		"logs/app.log":        "user joao.silva@gmail.com logged in\n",
+		"vendor/fixture.txt":  "111.444.777-35\n",
+		"testdata/skip.json":  `{"cpf": "111.444.777-35"}`,
+		".git/objects/foo.md": "111.444.777-35\n",
+	})
+
+	tests := []struct {
+		args   []string
+		status int
+		expect []string
+	}{
+		{
+			[]string{"-exclude", "vendor", "-exclude", "*.json", dir},
+			exitFindings,
+			[]string{
+				filepath.Join(dir, "logs/app.log") + ":1:6: email_address (severity 3)",
+				filepath.Join(dir, "users.txt") + ":2:6: brazilian_CPF (severity 3)",
+			},
+		},
+		{
+			[]string{"-include", "*.txt", "-exclude", "vendor", dir},
+			exitFindings,
+			[]string{filepath.Join(dir, "users.txt") + ":2:6: brazilian_CPF (severity 3)"},
+		},
+		{
+			[]string{"-include", "*.txt", "-exclude", "vendor", "-fail-severity", "4", dir},
+			exitOK,
+			[]string{filepath.Join(dir, "users.txt") + ":2:6: brazilian_CPF (severity 3)"},
+		},
+		{
+			[]string{"-min-severity", "4", dir},
+			exitOK,
+			nil,
+		},
+		{
+			[]string{filepath.Join(dir, "clean.txt")},
+			exitOK,
+			nil,
+		},
+		{
+			[]string{"-ruleset", "unknown", dir},
+			exitError,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		status := run(append([]string{"scan"}, test.args...), nil, &stdout, &stderr)
+		if status != test.status {
+			t.Errorf("For args %q expected status %d but got %d (stderr: %s)", test.args, test.status, status, stderr.String())
+		}
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		if len(test.expect) == 0 {
+			if stdout.Len() != 0 {
+				t.Errorf("For args %q expected no output but got %q", test.args, stdout.String())
+			}
+			continue
+		}
+		if len(lines) != len(test.expect) {
+			t.Errorf("For args %q expected %d findings but got %q", test.args, len(test.expect), stdout.String())
+			continue
+		}
+		for i, line := range lines {
+			if !strings.HasPrefix(line, test.expect[i]) {
+				t.Errorf("For args %q expected line %q to start with %q", test.args, line, test.expect[i])
+			}
+		}
+	}
+}
+
+func TestRunScanRulesFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"rules.json": `{"rules": [{"name": "employee_id", "severity": 2, "pattern": "^EMP-[0-9]{6}$"}]}`,
+		"data.txt":   "owner EMP-123456 cpf 111.444.777-35\n",
+	})
+
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"scan", "-rules", filepath.Join(dir, "rules.json"), "-include", "*.txt", dir}, nil, &stdout, &stderr)
+	if status != exitFindings {
+		t.Fatalf("Expected status %d but got %d (stderr: %s)", exitFindings, status, stderr.String())
+	}
+
+	expect := filepath.Join(dir, "data.txt") + ":1:7: employee_id (severity 2)"
+	if !strings.HasPrefix(stdout.String(), expect) || strings.Count(stdout.String(), "\n") != 1 {
+		t.Errorf("Expected a single finding %q but got %q", expect, stdout.String())
+	}
+}
+
+func TestIsBinary(t *testing.T) {
+	if isBinary([]byte("plain text\n")) {
+		t.Errorf("Expected text not to be binary")
+	}
+	if !isBinary([]byte("PK\x03\x04\x00")) {
+		t.Errorf("Expected data with NUL bytes to be binary")
+	}
+}
+
+func TestRunScanSARIF(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"users.txt": "cpf: 111.444.777-35\n",
+	})
+
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"scan", "-format", "sarif", dir}, nil, &stdout, &stderr)
+	if status != exitFindings {
+		t.Fatalf("Expected status %d but got %d (stderr: %s)", exitFindings, status, stderr.String())
+	}
+
+	var log struct {
+		Runs []struct {
+			Results []struct {
+				RuleID string `json:"ruleId"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &log); err != nil {
+		t.Fatalf("Expected a SARIF log but got %q: %v", stdout.String(), err)
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 1 || log.Runs[0].Results[0].RuleID != "brazilian_CPF" {
+		t.Errorf("Unexpected SARIF log %s", stdout.String())
+	}
+}
+
+func TestRunScanFormats(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"users.txt": "cpf: 111.444.777-35\n",
+	})
+
+	tests := []struct {
+		args   []string
+		status int
+		expect string
+	}{
+		{[]string{"-format", "jsonl"}, exitFindings, `"match":"11**********35"`},
+		{[]string{"-format", "jsonl", "-show-matches"}, exitFindings, `"match":"111.444.777-35"`},
+		{[]string{"-format", "csv"}, exitFindings, "file,line,column,rule,description,severity,match,commit,author,metadata\n"},
+		{[]string{"-format", "table", "-color", "never"}, exitFindings, "1 findings\n"},
+		{[]string{"-format", "xml"}, exitError, ""},
+		{[]string{"-color", "sometimes"}, exitError, ""},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		status := run(append(append([]string{"scan"}, test.args...), dir), nil, &stdout, &stderr)
+		if status != test.status {
+			t.Errorf("For args %q expected status %d but got %d (stderr: %s)", test.args, test.status, status, stderr.String())
+		}
+		if !strings.Contains(stdout.String(), test.expect) {
+			t.Errorf("For args %q expected the output to contain %q but got %q", test.args, test.expect, stdout.String())
+		}
+	}
+}
@@ -8,6 +8,44 @@ var (
 		"email_address": DefaultEmailRule,
 		"ip_address":    DefaultIPRule,
 		"credit_card":   DefaultCreditCardRule,
+		"phone_number":  DefaultBrazilianPhoneRule,
+	}
+
+	// BrazilianRuleSet provides a rule set of Brazilian identification numbers
+	BrazilianRuleSet = RuleSet{
+		"cpf_number":   DefaultCPFRule,
+		"cnpj_number":  DefaultCNPJRule,
+		"rg_number":    DefaultRGRule,
+		"cnh_number":   DefaultCNHRule,
+		"pis_number":   DefaultPISRule,
+		"voter_id":     DefaultTituloEleitorRule,
+		"cns_number":   DefaultCNSRule,
+		"pix_key":      DefaultPixKeyRule,
+		"boleto":       DefaultBoletoRule,
+		"phone_number": DefaultBrazilianPhoneRule,
+		"address":      DefaultBrazilianAddressRule,
+		"cep":          DefaultCEPRule,
+		"bank_account": DefaultBrazilianBankAccountRule,
+	}
+
+	// VehicleRuleSet provides a rule set of vehicle identification numbers
+	VehicleRuleSet = RuleSet{
+		"vin":           DefaultVINRule,
+		"license_plate": DefaultPlacaRule,
+		"renavam":       DefaultRENAVAMRule,
+	}
+
+	// USRuleSet provides a rule set of US taxpayer identification numbers
+	USRuleSet = RuleSet{
+		"ssn":  DefaultSSNRule,
+		"itin": DefaultITINRule,
+		"ein":  DefaultEINRule,
+	}
+
+	// BankingRuleSet provides a rule set of bank account numbers
+	BankingRuleSet = RuleSet{
+		"iban":         DefaultIBANRule,
+		"bank_account": DefaultBrazilianBankAccountRule,
 	}
 
 	// DefaultCPFRule is a default rule for Brazilian CPF
@@ -16,6 +54,7 @@ var (
 		Description: "Brazilian CPF",
 		Severity:    3,
 		Filter:      CPF(),
+		Describe:    DescribeAmbiguous(map[string]Matcher{"CNH": CNH(), "PIS": PIS()}),
 	}
 
 	// DefaultCNPJRule is a default rule for Brazilian CNPJ
@@ -24,6 +63,176 @@ var (
 		Description: "Brazilian CNPJ",
 		Severity:    3,
 		Filter:      CNPJ(),
+		Locate:      CNPJLocator(),
+	}
+
+	// DefaultRGRule is a default rule for Brazilian RG
+	DefaultRGRule = Rule{
+		Name:        "brazilian_RG",
+		Description: "Brazilian RG",
+		Severity:    3,
+		Filter:      RG(),
+		Locate:      RGLocator(),
+	}
+
+	// DefaultCNHRule is a default rule for Brazilian CNH (driver's license)
+	DefaultCNHRule = Rule{
+		Name:        "brazilian_CNH",
+		Description: "Brazilian CNH (driver's license)",
+		Severity:    3,
+		Filter:      CNH(),
+		Locate:      CNHLocator(),
+		Describe:    DescribeAmbiguous(map[string]Matcher{"CPF": CPF(), "PIS": PIS()}),
+	}
+
+	// DefaultPISRule is a default rule for Brazilian PIS/PASEP/NIT/NIS
+	DefaultPISRule = Rule{
+		Name:        "brazilian_PIS",
+		Description: "Brazilian PIS/PASEP/NIT/NIS",
+		Severity:    3,
+		Filter:      PIS(),
+		Describe:    DescribeAmbiguous(map[string]Matcher{"CPF": CPF(), "CNH": CNH(), "RENAVAM": RENAVAM()}),
+	}
+
+	// DefaultTituloEleitorRule is a default rule for Brazilian voter ID (título de eleitor)
+	DefaultTituloEleitorRule = Rule{
+		Name:        "brazilian_titulo_eleitor",
+		Description: "Brazilian voter ID (título de eleitor)",
+		Severity:    3,
+		Filter:      TituloEleitor(),
+		Locate:      TituloEleitorLocator(),
+	}
+
+	// DefaultCNSRule is a default rule for Brazilian health card (Cartão Nacional de Saúde).
+	// Health data is sensitive personal data, so its severity is higher than CPF.
+	DefaultCNSRule = Rule{
+		Name:        "brazilian_CNS",
+		Description: "Brazilian health card (Cartão Nacional de Saúde)",
+		Severity:    4,
+		Filter:      CNS(),
+		Locate:      CNSLocator(),
+	}
+
+	// DefaultPixKeyRule is a default rule for Brazilian Pix keys
+	DefaultPixKeyRule = Rule{
+		Name:        "pix_key",
+		Description: "Brazilian Pix key",
+		Severity:    3,
+		Filter:      PixKey(),
+		Locate:      PixKeyLocator(),
+		Describe:    DescribePixKey(),
+	}
+
+	// DefaultBoletoRule is a default rule for Brazilian boleto typed lines
+	DefaultBoletoRule = Rule{
+		Name:        "brazilian_boleto",
+		Description: "Brazilian boleto typed line (linha digitável)",
+		Severity:    3,
+		Filter:      Boleto(),
+		Locate:      BoletoLocator(),
+	}
+
+	// DefaultBrazilianPhoneRule is a default rule for Brazilian phone numbers
+	DefaultBrazilianPhoneRule = Rule{
+		Name:        "brazilian_phone",
+		Description: "Brazilian phone number",
+		Severity:    2,
+		Filter:      BrazilianPhone(),
+		Locate:      BrazilianPhoneLocator(),
+	}
+
+	// DefaultBrazilianAddressRule is a default rule for Brazilian street address
+	DefaultBrazilianAddressRule = Rule{
+		Name:        "brazilian_address",
+		Description: "Brazilian street address",
+		Severity:    3,
+		Filter:      BrazilianAddress(),
+		Locate:      BrazilianAddressLocator(),
+	}
+
+	// DefaultCEPRule is a default rule for Brazilian postal code (CEP). On its own a CEP
+	// only locates a region, so its severity is lower than a full address.
+	DefaultCEPRule = Rule{
+		Name:        "brazilian_CEP",
+		Description: "Brazilian postal code (CEP)",
+		Severity:    1,
+		Filter:      CEP(),
+		Locate:      CEPLocator(),
+		Describe:    DescribeCEP(),
+	}
+
+	// DefaultVINRule is a default rule for vehicle identification number
+	DefaultVINRule = Rule{
+		Name:        "vehicle_VIN",
+		Description: "vehicle identification number (VIN)",
+		Severity:    2,
+		Filter:      VIN(),
+	}
+
+	// DefaultPlacaRule is a default rule for Brazilian license plate
+	DefaultPlacaRule = Rule{
+		Name:        "brazilian_license_plate",
+		Description: "Brazilian license plate",
+		Severity:    2,
+		Filter:      PlacaMercosul(),
+		Locate:      PlacaLocator(),
+	}
+
+	// DefaultRENAVAMRule is a default rule for Brazilian vehicle registration (RENAVAM)
+	DefaultRENAVAMRule = Rule{
+		Name:        "brazilian_RENAVAM",
+		Description: "Brazilian vehicle registration (RENAVAM)",
+		Severity:    3,
+		Filter:      RENAVAM(),
+		Locate:      RENAVAMLocator(),
+		Describe:    DescribeAmbiguous(map[string]Matcher{"CPF": CPF(), "CNH": CNH(), "PIS": PIS()}),
+	}
+
+	// DefaultBrazilianBankAccountRule is a default rule for Brazilian bank agency and account
+	DefaultBrazilianBankAccountRule = Rule{
+		Name:        "brazilian_bank_account",
+		Description: "Brazilian bank agency and account",
+		Severity:    3,
+		Filter:      BrazilianBankAccount(),
+		Locate:      BrazilianBankAccountLocator(),
+		Describe:    DescribeBrazilianBank(),
+	}
+
+	// DefaultIBANRule is a default rule for IBAN
+	DefaultIBANRule = Rule{
+		Name:        "iban",
+		Description: "IBAN bank account number",
+		Severity:    3,
+		Filter:      BankInfo(),
+		Locate:      IBANLocator(),
+		Describe:    DescribeIBAN(),
+	}
+
+	// DefaultSSNRule is a default rule for US social security number
+	DefaultSSNRule = Rule{
+		Name:        "us_SSN",
+		Description: "US social security number",
+		Severity:    5,
+		Filter:      SSN(),
+		Locate:      RegexpLocator(ssnRegexp, SSN()),
+	}
+
+	// DefaultITINRule is a default rule for US individual taxpayer identification number
+	DefaultITINRule = Rule{
+		Name:        "us_ITIN",
+		Description: "US individual taxpayer identification number",
+		Severity:    5,
+		Filter:      ITIN(),
+		Locate:      RegexpLocator(ssnRegexp, ITIN()),
+	}
+
+	// DefaultEINRule is a default rule for US employer identification number
+	DefaultEINRule = Rule{
+		Name:        "us_EIN",
+		Description: "US employer identification number",
+		Severity:    2,
+		Filter:      EIN(),
+		Locate:      RegexpLocator(einRegexp, EIN()),
 	}
 
 	// DefaultEmailRule is a default rule for email address
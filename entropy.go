@@ -0,0 +1,184 @@
+package leakspok
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	entropyTokenPattern = `[A-Za-z0-9+/_.:~%@-]*[A-Za-z0-9+/_~%@-]={0,2}`
+	hexPattern          = `^[0-9a-fA-F]+$`
+	base64Pattern       = `^[A-Za-z0-9+/_-]+={0,2}$`
+	hashPattern         = `^(?:[0-9a-fA-F]{32}|[0-9a-fA-F]{40}|[0-9a-fA-F]{56}|[0-9a-fA-F]{64}|[0-9a-fA-F]{96}|[0-9a-fA-F]{128})$`
+)
+
+var (
+	entropyTokenRegexp = regexp.MustCompile(entropyTokenPattern)
+	hexRegexp          = regexp.MustCompile(hexPattern)
+	base64Regexp       = regexp.MustCompile(base64Pattern)
+	hashRegexp         = regexp.MustCompile(hashPattern)
+	urlSchemaRegexp    = regexp.MustCompile(urlSchemaPattern)
+)
+
+var (
+	// EntropyRuleSet provides a rule set of random-looking strings, such as
+	// unknown tokens and keys
+	EntropyRuleSet = RuleSet{
+		"high_entropy": DefaultHighEntropyRule,
+	}
+
+	// DefaultHighEntropyRule is a default rule for random-looking strings with
+	// at least 20 characters
+	DefaultHighEntropyRule = Rule{
+		Name:        "high_entropy",
+		Description: "High-entropy string",
+		Severity:    3,
+		Filter:      HighEntropy(20, 4.0),
+		Locate:      HighEntropyLocator(20, 4.0),
+		Describe:    DescribeEntropy(),
+	}
+)
+
+// HighEntropy generates a matcher for identifying random-looking base64 or hex
+// strings with at least minLen characters, such as unknown tokens and keys.
+// Their Shannon entropy, in bits per character, must reach threshold scaled to
+// the character classes they use: all of base64 carries 6 bits per character,
+// while hex strings only carry 4 and need two thirds of it. Letters must be
+// mixed with digits, or with "+" or "/" in base64, which leaves out
+// identifiers such as "AbstractSingletonProxyFactoryBean". UUIDs, file names,
+// hashes and URLs are not matched.
+func HighEntropy(minLen int, threshold float64) Matcher {
+	return All(
+		func(s string) bool {
+			return len(s) >= minLen && entropyScore(s) >= entropyThreshold(s, threshold)
+		},
+		matchMixedClasses,
+		Not(
+			Any(
+				matchUUIDToken,
+				matchfilename,
+				matchHash,
+				matchSchemeURL,
+			),
+		),
+	)
+}
+
+// HighEntropyLocator generates a locator for the strings matched by
+// HighEntropy within a text
+func HighEntropyLocator(minLen int, threshold float64) Locator {
+	return RegexpLocator(entropyTokenRegexp, HighEntropy(minLen, threshold))
+}
+
+// DescribeEntropy generates a describer reporting the character set and the
+// Shannon entropy of a match, so the threshold of HighEntropy can be tuned
+func DescribeEntropy() Describer {
+	return func(s string, loc []int) map[string]string {
+		match := s[loc[0]:loc[1]]
+		charset := entropyCharset(match)
+		if charset == "" {
+			return nil
+		}
+		return map[string]string{
+			"charset": charset,
+			"entropy": strconv.FormatFloat(entropyScore(match), 'f', 2, 64),
+		}
+	}
+}
+
+// entropyCharset returns "hex" or "base64" according to the characters of s,
+// or "" when s has other characters
+func entropyCharset(s string) string {
+	switch {
+	case hexRegexp.MatchString(s):
+		return "hex"
+	case base64Regexp.MatchString(s):
+		return "base64"
+	default:
+		return ""
+	}
+}
+
+// entropyThreshold returns the threshold of s: threshold scaled by the bits per
+// character of the alphabet of s, out of the 6 bits of base64, and an
+// unreachable one for strings that are neither base64 nor hex
+func entropyThreshold(s string, threshold float64) float64 {
+	switch entropyCharset(s) {
+	case "hex":
+		return threshold * 4 / 6
+	case "base64":
+		return threshold * math.Log2(float64(entropyAlphabet(s))) / 6
+	default:
+		return math.Inf(1)
+	}
+}
+
+// entropyAlphabet returns the size of the base64 alphabet restricted to the
+// character classes of s: uppercase and lowercase letters, digits and symbols
+func entropyAlphabet(s string) int {
+	size := 0
+	for _, class := range []struct {
+		chars string
+		size  int
+	}{
+		{"ABCDEFGHIJKLMNOPQRSTUVWXYZ", 26},
+		{"abcdefghijklmnopqrstuvwxyz", 26},
+		{"0123456789", 10},
+		{"+/_-", 2},
+	} {
+		if strings.ContainsAny(s, class.chars) {
+			size += class.size
+		}
+	}
+	return size
+}
+
+// matchMixedClasses returns a match for strings mixing letters with digits,
+// or with "+" or "/" as base64 does
+func matchMixedClasses(s string) bool {
+	letters := strings.ContainsAny(s, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+	return letters && strings.ContainsAny(s, "0123456789+/")
+}
+
+// entropyScore returns the Shannon entropy of s in bits per character,
+// ignoring the base64 padding
+func entropyScore(s string) float64 {
+	s = strings.TrimRight(s, "=")
+	if s == "" {
+		return 0
+	}
+
+	counts := map[rune]int{}
+	for _, r := range s {
+		counts[r]++
+	}
+
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(len(s))
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// matchUUIDToken returns a match for strings that are a whole UUID. UUID
+// alone also accepts any string with 32 hex digits in a row, which would
+// exclude every long hex secret.
+func matchUUIDToken(s string) bool {
+	return fullMatch(guidRegexp, s) && UUID()(s)
+}
+
+// matchHash returns a hex match with the length of an MD5, SHA-1 or SHA-2
+// digest, which are random-looking but rarely secret
+func matchHash(s string) bool {
+	return hashRegexp.MatchString(s)
+}
+
+// matchSchemeURL returns a match for strings starting with a URL scheme, such
+// as "https://"
+func matchSchemeURL(s string) bool {
+	loc := urlSchemaRegexp.FindStringIndex(s)
+	return loc != nil && loc[0] == 0
+}
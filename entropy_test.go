@@ -0,0 +1,79 @@
+package leakspok
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEntropyScore(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect float64
+	}{
+		{"", 0},
+		{"aaaa", 0},
+		{"abab", 1},
+		{"abcd", 2},
+		{"0123456789abcdef", 4},
+		{"abcd==", 2},
+	}
+
+	for _, test := range tests {
+		if got := entropyScore(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestHighEntropy(t *testing.T) {
+	m := HighEntropy(20, 4.0)
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"kJ8sZ2pQ7vXm4NwR9tYbLc3FhGd6eUaS", true},
+		{"wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", true},
+		{"Zm9vYmFyYmF6cXV4MTIzNDU2Nzg5MA==", true},
+		{"3f9a1c7e5b2d8046f1a9c3e7b5d20864af1c", true},
+		{"kJ8sZ2pQ7vXm4NwR9", false},
+		{"thisisnotasecretatallreally", false},
+		{"AbstractSingletonProxyFactoryBean", false},
+		{"TestAnonymizeStreamChunkBoundary", false},
+		{"DefaultHighEntropyRule", false},
+		{"DefaultSpanishNIERule", false},
+		{"12345678901234567890123", false},
+		{"passwordpasswordpassword", false},
+		{"d41d8cd98f00b204e9800998ecf8427e", false},
+		{"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", false},
+		{"550e8400-e29b-41d4-a716-446655440000", false},
+		{"kJ8sZ2pQ7vXm4NwR9tYbLc3FhGd6eUaS.json", false},
+		{"https://kJ8sZ2pQ7vXm4NwR9tYbLc3FhGd6eUaS", false},
+	}
+
+	for _, test := range tests {
+		if got := m(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+
+	if HighEntropy(20, 5.5)("kJ8sZ2pQ7vXm4NwR9tYbLc3FhGd6eUaS") {
+		t.Errorf("Expected a higher threshold not to match")
+	}
+	if !HighEntropy(10, 3.0)("kJ8sZ2pQ7vXm4") {
+		t.Errorf("Expected a lower minimum length to match")
+	}
+}
+
+func TestFindAllHighEntropy(t *testing.T) {
+	input := `{"user": "joao", "session_token": "kJ8sZ2pQ7vXm4NwR9tYbLc3FhGd6eUaS", "id": "550e8400-e29b-41d4-a716-446655440000"}`
+
+	findings := NewStringTester(EntropyRuleSet).FindAll(input)
+	if len(findings) != 1 || findings[0].Match != "kJ8sZ2pQ7vXm4NwR9tYbLc3FhGd6eUaS" {
+		t.Fatalf("For input %q expected a single finding but got %v", input, findings)
+	}
+
+	expected := map[string]string{"charset": "base64", "entropy": "5.00"}
+	if got := findings[0].Metadata; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v but got %v", expected, got)
+	}
+}
@@ -0,0 +1,523 @@
+package leakspok
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	spanishDNIPattern    = `(?i)\d{8}[- ]?[A-Z]`
+	spanishNIEPattern    = `(?i)[XYZ]-?\d{7}[- ]?[A-Z]`
+	portugueseNIFPattern = `\d{3} ?\d{3} ?\d{3}`
+	codiceFiscalePattern = `(?i)[A-Z]{6}[\dLMNP-V]{2}[ABCDEHLMPRST][\dLMNP-V]{2}[A-Z][\dLMNP-V]{3}[A-Z]`
+	nirPattern           = `(?i)[1-478] ?\d{2} ?(?:0[1-9]|1[0-2]|[2-9]\d) ?(?:\d{2}|2[AB]) ?\d{3} ?\d{3} ?\d{2}`
+	steuerIDPattern      = `[1-9]\d ?\d{3} ?\d{3} ?\d{3}`
+	bsnPattern           = `\d{4}\.\d{2}\.\d{3}|\d{9}`
+	ninoPattern          = `(?i)[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]`
+	nhsNumberPattern     = `\d{3}[- ]?\d{3}[- ]?\d{4}`
+)
+
+var (
+	spanishDNIRegexp    = regexp.MustCompile(spanishDNIPattern)
+	spanishNIERegexp    = regexp.MustCompile(spanishNIEPattern)
+	portugueseNIFRegexp = regexp.MustCompile(portugueseNIFPattern)
+	codiceFiscaleRegexp = regexp.MustCompile(codiceFiscalePattern)
+	nirRegexp           = regexp.MustCompile(nirPattern)
+	steuerIDRegexp      = regexp.MustCompile(steuerIDPattern)
+	bsnRegexp           = regexp.MustCompile(bsnPattern)
+	ninoRegexp          = regexp.MustCompile(ninoPattern)
+	nhsNumberRegexp     = regexp.MustCompile(nhsNumberPattern)
+)
+
+// portugueseNIFKeywords are the words that usually come with a Portuguese NIF
+var portugueseNIFKeywords = []string{
+	"nif", "nipc", "contribuinte", "número de contribuinte", "numero de contribuinte",
+}
+
+// steuerIDKeywords are the words that usually come with a German Steuer-ID
+var steuerIDKeywords = []string{
+	"steuer-id", "steuer-idnr", "steuerid", "idnr", "identifikationsnummer", "steueridentifikationsnummer",
+	"steuerliche identifikationsnummer", "tin",
+}
+
+// bsnKeywords are the words that usually come with a Dutch BSN
+var bsnKeywords = []string{
+	"bsn", "burgerservicenummer", "sofinummer", "sofi-nummer",
+}
+
+// nhsNumberKeywords are the words that usually come with a UK NHS number
+var nhsNumberKeywords = []string{
+	"nhs", "nhs no", "nhs number", "nhs no.",
+}
+
+// The severities of the European rules follow the GDPR: national
+// identification numbers (Article 87), including the Dutch BSN, are 4. French
+// NIRs and NHS numbers are 5, as they identify patients in health insurance
+// and health records, which are special category data (Article 9). Portuguese
+// NIFs are 3, as they are also given to companies.
+var (
+	// SpainRuleSet provides a rule set of Spanish identification numbers
+	SpainRuleSet = RuleSet{
+		"dni_number": DefaultSpanishDNIRule,
+		"nie_number": DefaultSpanishNIERule,
+	}
+
+	// PortugalRuleSet provides a rule set of Portuguese identification numbers
+	PortugalRuleSet = RuleSet{
+		"nif_number": DefaultPortugueseNIFRule,
+	}
+
+	// ItalyRuleSet provides a rule set of Italian identification numbers
+	ItalyRuleSet = RuleSet{
+		"codice_fiscale": DefaultCodiceFiscaleRule,
+	}
+
+	// FranceRuleSet provides a rule set of French identification numbers
+	FranceRuleSet = RuleSet{
+		"nir_number": DefaultNIRRule,
+	}
+
+	// GermanyRuleSet provides a rule set of German identification numbers
+	GermanyRuleSet = RuleSet{
+		"steuer_id": DefaultSteuerIDRule,
+	}
+
+	// NetherlandsRuleSet provides a rule set of Dutch identification numbers
+	NetherlandsRuleSet = RuleSet{
+		"bsn_number": DefaultBSNRule,
+	}
+
+	// UKRuleSet provides a rule set of United Kingdom identification numbers
+	UKRuleSet = RuleSet{
+		"nino":       DefaultNINORule,
+		"nhs_number": DefaultNHSNumberRule,
+	}
+
+	// DefaultSpanishDNIRule is a default rule for Spanish DNI
+	DefaultSpanishDNIRule = Rule{
+		Name:        "spain_DNI",
+		Description: "Spanish DNI",
+		Severity:    4,
+		Filter:      SpanishDNI(),
+		Locate:      RegexpLocator(spanishDNIRegexp, SpanishDNI()),
+	}
+
+	// DefaultSpanishNIERule is a default rule for Spanish NIE
+	DefaultSpanishNIERule = Rule{
+		Name:        "spain_NIE",
+		Description: "Spanish NIE (foreigner identity number)",
+		Severity:    4,
+		Filter:      SpanishNIE(),
+		Locate:      RegexpLocator(spanishNIERegexp, SpanishNIE()),
+	}
+
+	// DefaultPortugueseNIFRule is a default rule for Portuguese NIF
+	DefaultPortugueseNIFRule = Rule{
+		Name:        "portugal_NIF",
+		Description: "Portuguese NIF (tax number)",
+		Severity:    3,
+		Filter:      PortugueseNIF(),
+		Locate:      PortugueseNIFLocator(),
+	}
+
+	// DefaultCodiceFiscaleRule is a default rule for Italian codice fiscale
+	DefaultCodiceFiscaleRule = Rule{
+		Name:        "italy_codice_fiscale",
+		Description: "Italian codice fiscale",
+		Severity:    4,
+		Filter:      CodiceFiscale(),
+		Locate:      RegexpLocator(codiceFiscaleRegexp, CodiceFiscale()),
+	}
+
+	// DefaultNIRRule is a default rule for French NIR
+	DefaultNIRRule = Rule{
+		Name:        "france_NIR",
+		Description: "French NIR (social security number)",
+		Severity:    5,
+		Filter:      NIR(),
+		Locate:      RegexpLocator(nirRegexp, NIR()),
+	}
+
+	// DefaultSteuerIDRule is a default rule for German Steuer-ID
+	DefaultSteuerIDRule = Rule{
+		Name:        "germany_steuer_id",
+		Description: "German Steuer-ID (tax identification number)",
+		Severity:    4,
+		Filter:      SteuerID(),
+		Locate:      SteuerIDLocator(),
+	}
+
+	// DefaultBSNRule is a default rule for Dutch BSN
+	DefaultBSNRule = Rule{
+		Name:        "netherlands_BSN",
+		Description: "Dutch BSN (citizen service number)",
+		Severity:    4,
+		Filter:      BSN(),
+		Locate:      BSNLocator(),
+	}
+
+	// DefaultNINORule is a default rule for UK National Insurance numbers
+	DefaultNINORule = Rule{
+		Name:        "uk_NINO",
+		Description: "UK National Insurance number",
+		Severity:    4,
+		Filter:      NINO(),
+		Locate:      RegexpLocator(ninoRegexp, NINO()),
+	}
+
+	// DefaultNHSNumberRule is a default rule for UK NHS numbers
+	DefaultNHSNumberRule = Rule{
+		Name:        "uk_NHS_number",
+		Description: "UK NHS number",
+		Severity:    5,
+		Filter:      NHSNumber(),
+		Locate:      NHSNumberLocator(),
+	}
+)
+
+// SpanishDNI generates a matcher for identifying Spanish DNIs, validating
+// their check letter
+func SpanishDNI() Matcher {
+	return Any(
+		matchSpanishDNI,
+	)
+}
+
+// SpanishNIE generates a matcher for identifying Spanish NIEs, validating
+// their check letter
+func SpanishNIE() Matcher {
+	return Any(
+		matchSpanishNIE,
+	)
+}
+
+// PortugueseNIF generates a matcher for identifying Portuguese NIFs,
+// validating their prefix and check digit
+func PortugueseNIF() Matcher {
+	return Any(
+		matchPortugueseNIF,
+	)
+}
+
+// PortugueseNIFLocator generates a locator for Portuguese NIFs that come after
+// or before a keyword such as "NIF" or "contribuinte"
+func PortugueseNIFLocator() Locator {
+	return WithContext(RegexpLocator(portugueseNIFRegexp, PortugueseNIF()), 40, portugueseNIFKeywords...)
+}
+
+// CodiceFiscale generates a matcher for identifying Italian codici fiscali,
+// validating their birth day and check letter
+func CodiceFiscale() Matcher {
+	return Any(
+		matchCodiceFiscale,
+	)
+}
+
+// NIR generates a matcher for identifying French NIRs (numéro de sécurité
+// sociale), validating their key
+func NIR() Matcher {
+	return Any(
+		matchNIR,
+	)
+}
+
+// SteuerID generates a matcher for identifying German Steuer-IDs, validating
+// their digit distribution and check digit
+func SteuerID() Matcher {
+	return Any(
+		matchSteuerID,
+	)
+}
+
+// SteuerIDLocator generates a locator for German Steuer-IDs that come after
+// or before a keyword such as "Steuer-ID" or "IdNr"
+func SteuerIDLocator() Locator {
+	return WithContext(RegexpLocator(steuerIDRegexp, SteuerID()), 40, steuerIDKeywords...)
+}
+
+// BSN generates a matcher for identifying Dutch BSNs, validating the 11-proof
+func BSN() Matcher {
+	return Any(
+		matchBSN,
+	)
+}
+
+// BSNLocator generates a locator for Dutch BSNs that come after or before a
+// keyword such as "BSN"
+func BSNLocator() Locator {
+	return WithContext(RegexpLocator(bsnRegexp, BSN()), 40, bsnKeywords...)
+}
+
+// NINO generates a matcher for identifying UK National Insurance numbers,
+// validating their prefix and suffix letters
+func NINO() Matcher {
+	return Any(
+		matchNINO,
+	)
+}
+
+// NHSNumber generates a matcher for identifying UK NHS numbers, validating
+// their check digit
+func NHSNumber() Matcher {
+	return Any(
+		matchNHSNumber,
+	)
+}
+
+// NHSNumberLocator generates a locator for UK NHS numbers that come after or
+// before a keyword such as "NHS number"
+func NHSNumberLocator() Locator {
+	return WithContext(RegexpLocator(nhsNumberRegexp, NHSNumber()), 40, nhsNumberKeywords...)
+}
+
+// spanishIDLetters are the check letters of Spanish DNIs and NIEs, indexed by
+// the number modulo 23
+const spanishIDLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// matchSpanishDNI returns a Spanish DNI match in the "12345678Z" format, also
+// with a hyphen or space before the letter. The letter is the number modulo 23
+// within spanishIDLetters.
+func matchSpanishDNI(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(spanishDNIRegexp, s) {
+		return false
+	}
+	s = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(s))
+
+	n, _ := strconv.Atoi(s[:8])
+	return s[8] == spanishIDLetters[n%23]
+}
+
+// matchSpanishNIE returns a Spanish NIE match in the "X1234567L" format. The
+// X, Y and Z prefixes stand for 0, 1 and 2, and the letter is computed as for
+// a DNI.
+func matchSpanishNIE(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(spanishNIERegexp, s) {
+		return false
+	}
+	s = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(s))
+
+	n, _ := strconv.Atoi(strconv.Itoa(strings.IndexByte("XYZ", s[0])) + s[1:8])
+	return s[8] == spanishIDLetters[n%23]
+}
+
+// matchPortugueseNIF returns a Portuguese NIF match with 9 digits, optionally
+// grouped by three. The digits are weighted from 9 to 2, and the check digit is
+// 11 minus their sum modulo 11, or 0 when that is 10 or 11.
+func matchPortugueseNIF(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(portugueseNIFRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	// People start with 1, 2, 3 or 45, companies with 5, public bodies with 6
+	// and other entities with 7, 8 or 9
+	if !strings.ContainsAny(s[:1], "12356789") && !strings.HasPrefix(s, "45") {
+		return false
+	}
+
+	checkDigit := 11 - sumDigit(s[:8], []int{9, 8, 7, 6, 5, 4, 3, 2})%11
+	if checkDigit >= 10 {
+		checkDigit = 0
+	}
+
+	return int(s[8]-'0') == checkDigit
+}
+
+// codiceFiscaleOdd gives the value of the characters at odd positions of a
+// codice fiscale, indexed by the digit or letter
+var codiceFiscaleOdd = []int{
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23,
+}
+
+// codiceFiscaleDigits are the letters replacing the digits of a codice fiscale
+// when two people would have the same one (omocodia)
+const codiceFiscaleDigits = "LMNPQRSTUV"
+
+// matchCodiceFiscale returns an Italian codice fiscale match with 16
+// characters. The day of birth is 1 to 31, plus 40 for women, and the check
+// letter is the sum of the values of the other characters modulo 26.
+func matchCodiceFiscale(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(codiceFiscaleRegexp, s) {
+		return false
+	}
+	s = strings.ToUpper(s)
+
+	day := 0
+	for _, c := range s[9:11] {
+		if i := strings.IndexRune(codiceFiscaleDigits, c); i >= 0 {
+			c = rune('0' + i)
+		}
+		day = day*10 + int(c-'0')
+	}
+	if day < 1 || (day > 31 && day < 41) || day > 71 {
+		return false
+	}
+
+	sum := 0
+	for i, c := range s[:15] {
+		value := int(c - 'A')
+		if c <= '9' {
+			value = int(c - '0')
+		}
+		// Positions are 1-based, so the even indexes are the odd positions
+		if i%2 == 0 {
+			value = codiceFiscaleOdd[value]
+		}
+		sum += value
+	}
+
+	return s[15] == byte('A'+sum%26)
+}
+
+// matchNIR returns a French NIR match with 15 characters, optionally grouped
+// as in "1 85 05 78 006 084 91". The key is 97 minus the first 13 digits
+// modulo 97, where the Corsican departments 2A and 2B count as 19 and 18.
+func matchNIR(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(nirRegexp, s) {
+		return false
+	}
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+
+	number, err := strconv.ParseInt(strings.NewReplacer("2A", "19", "2B", "18").Replace(s[:13]), 10, 64)
+	if err != nil {
+		return false
+	}
+	key, _ := strconv.Atoi(s[13:])
+
+	return key == int(97-number%97)
+}
+
+// matchSteuerID returns a German Steuer-ID match with 11 digits. Within the
+// first ten, one digit appears twice or three times, though never three times
+// in a row, and the others at most once. The check digit follows ISO 7064
+// MOD 11,10.
+func matchSteuerID(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(steuerIDRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	var counts [10]int
+	for _, c := range s[:10] {
+		counts[c-'0']++
+	}
+	repeated := 0
+	for _, count := range counts {
+		switch {
+		case count == 2 || count == 3:
+			repeated++
+		case count > 3:
+			return false
+		}
+	}
+	if repeated != 1 {
+		return false
+	}
+	for i := 2; i < 10; i++ {
+		if s[i] == s[i-1] && s[i] == s[i-2] {
+			return false
+		}
+	}
+
+	product := 10
+	for _, c := range s[:10] {
+		sum := (int(c-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = sum * 2 % 11
+	}
+	checkDigit := 11 - product
+	if checkDigit == 10 {
+		checkDigit = 0
+	}
+
+	return int(s[10]-'0') == checkDigit
+}
+
+// matchBSN returns a Dutch BSN match with 9 digits, or in the "1112.22.333"
+// format. The digits are weighted from 9 to 2 and the last one by -1, and the
+// sum must be a multiple of 11 (the 11-proof).
+func matchBSN(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(bsnRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, ".", "")
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	sum := sumDigit(s[:8], []int{9, 8, 7, 6, 5, 4, 3, 2}) - int(s[8]-'0')
+	return sum > 0 && sum%11 == 0
+}
+
+// ninoInvalidPrefixes are the National Insurance prefixes that are never
+// issued
+var ninoInvalidPrefixes = []string{"BG", "GB", "KN", "NK", "NT", "TN", "ZZ"}
+
+// matchNINO returns a UK National Insurance number match in the "AB123456C"
+// format, optionally grouped as in "AB 12 34 56 C". D, F, I, Q, U and V are
+// never used in the prefix, nor O as its second letter, and the suffix is A to
+// D.
+func matchNINO(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(ninoRegexp, s) {
+		return false
+	}
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+
+	return !containsString(ninoInvalidPrefixes, s[:2])
+}
+
+// matchNHSNumber returns a UK NHS number match with 10 digits, optionally
+// grouped as in "943 476 5919". The digits are weighted from 10 to 2, and the
+// check digit is 11 minus their sum modulo 11: 11 becomes 0 and 10 is never
+// issued.
+func matchNHSNumber(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(nhsNumberRegexp, s) {
+		return false
+	}
+	s = strings.NewReplacer("-", "", " ", "").Replace(s)
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	checkDigit := 11 - sumDigit(s[:9], []int{10, 9, 8, 7, 6, 5, 4, 3, 2})%11
+	switch checkDigit {
+	case 11:
+		checkDigit = 0
+	case 10:
+		return false
+	}
+
+	return int(s[9]-'0') == checkDigit
+}
@@ -0,0 +1,224 @@
+package leakspok
+
+import "testing"
+
+func TestMatchSpanishDNI(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"12345678Z", true},
+		{"87654321-X", true},
+		{"12345678 z", true},
+		{"12345678A", false},
+		{"1234567Z", false},
+	}
+
+	for _, test := range tests {
+		if got := matchSpanishDNI(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchSpanishNIE(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"X1234567L", true},
+		{"Y-7654321-G", true},
+		{"Z0000001Y", true},
+		{"X1234567T", false},
+		{"A1234567L", false},
+	}
+
+	for _, test := range tests {
+		if got := matchSpanishNIE(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchPortugueseNIF(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"123456789", true},
+		{"500 000 000", true},
+		{"298765438", true},
+		{"123456788", false},
+		{"400000000", false},
+	}
+
+	for _, test := range tests {
+		if got := matchPortugueseNIF(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchCodiceFiscale(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"RSSMRA85T10A562S", true},
+		{"BNCGLI90A41F205H", true},
+		{"vrdlgu80l01h501t", true},
+		{"RSSMRA85T10A562T", false},
+		{"RSSMRA85T35A562S", false},
+		{"RSSMRA85Z10A562S", false},
+	}
+
+	for _, test := range tests {
+		if got := matchCodiceFiscale(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchNIR(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"185057800608491", true},
+		{"1 85 05 78 006 084 91", true},
+		{"290017512345605", true},
+		{"185052A12345633", true},
+		{"185057800608492", false},
+		{"585057800608491", false},
+	}
+
+	for _, test := range tests {
+		if got := matchNIR(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchSteuerID(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"86095742719", true},
+		{"86 095 742 719", true},
+		{"65621380498", true},
+		{"86095742718", false},
+		{"12345678903", false},
+		{"47110815049", false},
+		{"06095742719", false},
+	}
+
+	for _, test := range tests {
+		if got := matchSteuerID(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchBSN(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"111222333", true},
+		{"123456782", true},
+		{"1112.22.333", true},
+		{"123456789", false},
+		{"000000000", false},
+	}
+
+	for _, test := range tests {
+		if got := matchBSN(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchNINO(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"AB123456C", true},
+		{"AB 12 34 56 C", true},
+		{"ce123456a", true},
+		{"BG123456C", false},
+		{"DA123456C", false},
+		{"AO123456C", false},
+		{"AB123456E", false},
+	}
+
+	for _, test := range tests {
+		if got := matchNINO(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchNHSNumber(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"9434765919", true},
+		{"943 476 5919", true},
+		{"401-023-2137", true},
+		{"9434765918", false},
+		{"1234567890", false},
+	}
+
+	for _, test := range tests {
+		if got := matchNHSNumber(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestEuropeanContextLocators(t *testing.T) {
+	tests := []struct {
+		locator Locator
+		input   string
+		expect  []string
+	}{
+		{PortugueseNIFLocator(), "NIF: 123 456 789", []string{"123 456 789"}},
+		{PortugueseNIFLocator(), "encomenda 123456789", nil},
+		{SteuerIDLocator(), "Steuer-ID 86 095 742 719", []string{"86 095 742 719"}},
+		{SteuerIDLocator(), "Bestellung 86095742719", nil},
+		{BSNLocator(), "BSN 111222333", []string{"111222333"}},
+		{BSNLocator(), "order 111222333", nil},
+		{NHSNumberLocator(), "NHS number: 943 476 5919", []string{"943 476 5919"}},
+		{NHSNumberLocator(), "call 943 476 5919", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(test.locator, test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestFindAllEurope(t *testing.T) {
+	tests := []struct {
+		set    RuleSet
+		input  string
+		expect string
+	}{
+		{SpainRuleSet, "DNI 12345678Z", "spain_DNI"},
+		{SpainRuleSet, "NIE: X1234567L.", "spain_NIE"},
+		{ItalyRuleSet, "CF RSSMRA85T10A562S", "italy_codice_fiscale"},
+		{FranceRuleSet, "numéro de sécurité sociale 1 85 05 78 006 084 91", "france_NIR"},
+		{UKRuleSet, "NI number AB 12 34 56 C", "uk_NINO"},
+	}
+
+	for _, test := range tests {
+		findings := NewStringTester(test.set).FindAll(test.input)
+		if len(findings) != 1 || findings[0].Rule.Name != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, findings)
+		}
+	}
+}
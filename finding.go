@@ -0,0 +1,125 @@
+package leakspok
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Finding describes a single rule match within a scanned text. Commit and
+// Author are only set for findings within the history of a git repository,
+// and Metadata for rules with a Describer.
+type Finding struct {
+	Rule     Rule              `json:"rule"`
+	Match    string            `json:"match"`
+	File     string            `json:"file,omitempty"`
+	Line     int               `json:"line"`
+	Column   int               `json:"column"`
+	Commit   string            `json:"commit,omitempty"`
+	Author   string            `json:"author,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// FindAll returns every match of the rules within s, ordered by position.
+// Line and Column are 1-based and relative to s, so a whole file can be
+// scanned at once.
+func (t *StringTester) FindAll(s string) []Finding {
+	type located struct {
+		finding Finding
+		offset  int
+	}
+
+	var matches []located
+	for _, rule := range t.Rules {
+		for _, loc := range rule.locate(s) {
+			f := Finding{Rule: rule, Match: s[loc[0]:loc[1]]}
+			if rule.Describe != nil {
+				f.Metadata = rule.Describe(s, loc)
+			}
+			matches = append(matches, located{finding: f, offset: loc[0]})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].offset != matches[j].offset {
+			return matches[i].offset < matches[j].offset
+		}
+		return matches[i].finding.Rule.Name < matches[j].finding.Rule.Name
+	})
+
+	findings := make([]Finding, 0, len(matches))
+	for _, m := range matches {
+		m.finding.Line, m.finding.Column = lineColumn(s, m.offset)
+		findings = append(findings, m.finding)
+	}
+
+	return findings
+}
+
+// fieldsIndex splits s using the same delimiters as customFields, returning
+// the byte range [start, end) of each field. Escape sequences such as a
+// literal "\n" are treated as delimiters so the offsets stay within s.
+func fieldsIndex(s string) [][]int {
+	var locs [][]int
+	start := -1
+
+	for i := 0; i < len(s); {
+		r, width := utf8.DecodeRuneInString(s[i:])
+		separator := isFieldSeparator(r)
+		if r == '\\' && i+1 < len(s) && strings.IndexByte("ntr", s[i+1]) >= 0 {
+			width = 2
+		}
+
+		if separator && start >= 0 {
+			locs = append(locs, []int{start, i})
+			start = -1
+		} else if !separator && start < 0 {
+			start = i
+		}
+		i += width
+	}
+
+	if start >= 0 {
+		locs = append(locs, []int{start, len(s)})
+	}
+
+	return locs
+}
+
+// lineColumn converts a byte offset within s into a 1-based line and column.
+// Columns are counted in characters, not bytes.
+func lineColumn(s string, offset int) (int, int) {
+	before := s[:offset]
+	line := strings.Count(before, "\n") + 1
+	lineStart := strings.LastIndexByte(before, '\n') + 1
+	return line, utf8.RuneCountInString(before[lineStart:]) + 1
+}
+
+// maskSnippet masks a finding so it can be shown in reports. Only the first
+// and last two characters of long findings are kept.
+func maskSnippet(s string) string {
+	runes := []rune(s)
+	if len(runes) < 8 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:2]) + strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-2:])
+}
+
+// sensitiveMetadata are the metadata keys holding personal data, masked in
+// reports like the findings themselves
+var sensitiveMetadata = []string{"document_number", "birth_date"}
+
+// maskMetadata returns a copy of metadata with the sensitive values masked
+func maskMetadata(metadata map[string]string) map[string]string {
+	if metadata == nil {
+		return nil
+	}
+	masked := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		if containsString(sensitiveMetadata, key) {
+			value = maskSnippet(value)
+		}
+		masked[key] = value
+	}
+	return masked
+}
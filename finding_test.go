@@ -0,0 +1,162 @@
+package leakspok
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	tester := NewEmptyStringTester()
+	tester.Rules = []Rule{DefaultCPFRule, DefaultEmailRule}
+
+	input := "first line\n" +
+		`{"cpf": "111.444.777-35", "email": "joao.silva@gmail.com"}` + "\n" +
+		"ção 111444777-35,\n" +
+		"cpf 111.444.777-34"
+
+	expected := []Finding{
+		{Rule: DefaultCPFRule, Match: "111.444.777-35", Line: 2, Column: 10},
+		{Rule: DefaultEmailRule, Match: "joao.silva@gmail.com", Line: 2, Column: 37},
+		{Rule: DefaultCPFRule, Match: "111444777-35", Line: 3, Column: 5},
+	}
+
+	got := tester.FindAll(input)
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d findings but got %d: %+v", len(expected), len(got), got)
+	}
+
+	for i, f := range got {
+		e := expected[i]
+		if f.Rule.Name != e.Rule.Name || f.Match != e.Match || f.Line != e.Line || f.Column != e.Column {
+			t.Errorf("Finding %d: expected %s %q at %d:%d but got %s %q at %d:%d",
+				i, e.Rule.Name, e.Match, e.Line, e.Column, f.Rule.Name, f.Match, f.Line, f.Column)
+		}
+	}
+}
+
+func TestFindAllCNH(t *testing.T) {
+	tester := NewEmptyStringTester()
+	tester.Rules = []Rule{DefaultCPFRule, DefaultCNHRule}
+
+	input := "motorista 02650306461\n" +
+		"cpf 10000001333\n" +
+		"cnh 10000001333"
+
+	expected := []Finding{
+		{Rule: DefaultCNHRule, Match: "02650306461", Line: 1, Column: 11,
+			Metadata: map[string]string{"ambiguous_with": "PIS"}},
+		{Rule: DefaultCPFRule, Match: "10000001333", Line: 2, Column: 5,
+			Metadata: map[string]string{"ambiguous_with": "CNH"}},
+		{Rule: DefaultCNHRule, Match: "10000001333", Line: 3, Column: 5,
+			Metadata: map[string]string{"ambiguous_with": "CPF"}},
+		{Rule: DefaultCPFRule, Match: "10000001333", Line: 3, Column: 5,
+			Metadata: map[string]string{"ambiguous_with": "CNH"}},
+	}
+
+	got := tester.FindAll(input)
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d findings but got %d: %+v", len(expected), len(got), got)
+	}
+
+	for i, f := range got {
+		e := expected[i]
+		if f.Rule.Name != e.Rule.Name || f.Match != e.Match || f.Line != e.Line || f.Column != e.Column ||
+			!reflect.DeepEqual(f.Metadata, e.Metadata) {
+			t.Errorf("Finding %d: expected %s %q at %d:%d %v but got %s %q at %d:%d %v",
+				i, e.Rule.Name, e.Match, e.Line, e.Column, e.Metadata, f.Rule.Name, f.Match, f.Line, f.Column, f.Metadata)
+		}
+	}
+}
+
+func TestFindAllRENAVAM(t *testing.T) {
+	tester := NewEmptyStringTester()
+	tester.Rules = []Rule{DefaultPISRule, DefaultRENAVAMRule}
+
+	input := "renavam 00639884962"
+
+	expected := []Finding{
+		{Rule: DefaultPISRule, Match: "00639884962", Line: 1, Column: 9,
+			Metadata: map[string]string{"ambiguous_with": "CPF,RENAVAM"}},
+		{Rule: DefaultRENAVAMRule, Match: "00639884962", Line: 1, Column: 9,
+			Metadata: map[string]string{"ambiguous_with": "CPF,PIS"}},
+	}
+
+	got := tester.FindAll(input)
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d findings but got %d: %+v", len(expected), len(got), got)
+	}
+
+	for i, f := range got {
+		e := expected[i]
+		if f.Rule.Name != e.Rule.Name || f.Match != e.Match || f.Line != e.Line || f.Column != e.Column ||
+			!reflect.DeepEqual(f.Metadata, e.Metadata) {
+			t.Errorf("Finding %d: expected %s %q at %d:%d %v but got %s %q at %d:%d %v",
+				i, e.Rule.Name, e.Match, e.Line, e.Column, e.Metadata, f.Rule.Name, f.Match, f.Line, f.Column, f.Metadata)
+		}
+	}
+}
+
+func TestFindAllPixKey(t *testing.T) {
+	tester := NewEmptyStringTester()
+	tester.Rules = []Rule{DefaultPixKeyRule}
+
+	input := "chave pix: 123e4567-e89b-42d3-a456-556642440000\n" +
+		"request_id=123e4567-e89b-42d3-a456-556642440000\n" +
+		"celular +5511987654321\n" +
+		"pix via CPF 111.444.777-35\n" +
+		"cpf 111.444.777-35"
+
+	expected := []Finding{
+		{Match: "123e4567-e89b-42d3-a456-556642440000", Line: 1, Column: 12,
+			Metadata: map[string]string{"key_type": "evp", "confidence": "high"}},
+		{Match: "123e4567-e89b-42d3-a456-556642440000", Line: 2, Column: 12,
+			Metadata: map[string]string{"key_type": "evp", "confidence": "low"}},
+		{Match: "+5511987654321", Line: 3, Column: 9,
+			Metadata: map[string]string{"key_type": "phone", "confidence": "medium"}},
+		{Match: "111.444.777-35", Line: 4, Column: 13,
+			Metadata: map[string]string{"key_type": "cpf", "confidence": "high"}},
+	}
+
+	got := tester.FindAll(input)
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d findings but got %d: %+v", len(expected), len(got), got)
+	}
+
+	for i, f := range got {
+		e := expected[i]
+		if f.Match != e.Match || f.Line != e.Line || f.Column != e.Column || !reflect.DeepEqual(f.Metadata, e.Metadata) {
+			t.Errorf("Finding %d: expected %q at %d:%d %v but got %q at %d:%d %v",
+				i, e.Match, e.Line, e.Column, e.Metadata, f.Match, f.Line, f.Column, f.Metadata)
+		}
+	}
+}
+
+func TestFieldsIndex(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"a b", []string{"a", "b"}},
+		{`"a", [b]`, []string{"a", "b"}},
+		{`\njoe@gmail.com\n`, []string{"joe@gmail.com"}},
+		{"  ", nil},
+		{"", nil},
+	}
+
+	for _, test := range tests {
+		var got []string
+		for _, loc := range fieldsIndex(test.input) {
+			got = append(got, test.input[loc[0]:loc[1]])
+		}
+		if len(got) != len(test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.expect[i] {
+				t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package leakspok
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	cuitPattern           = `\d{2}-?\d{8}-?\d`
+	argentinaDNIPattern   = `\d{1,2}\.?\d{3}\.?\d{3}`
+	rutPattern            = `(?i)\d{1,2}\.?\d{3}\.?\d{3}-[\dk]`
+	nitPattern            = `\d{3}\.?\d{3}\.?\d{3}-\d`
+	colombiaCedulaPattern = `\d{1,3}(?:\.\d{3}){1,3}|\d{6,10}`
+	curpPattern           = `[A-Z][AEIOUX][A-Z]{2}\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])[HMX](?:AS|BC|BS|CC|CL|CM|CS|CH|DF|DG|GT|GR|HG|JC|MC|MN|MS|NT|NL|OC|PL|QT|QR|SP|SL|SR|TC|TS|TL|VZ|YN|ZS|NE)[B-DF-HJ-NP-TV-Z]{3}[0-9A-Z]\d`
+	rfcPattern            = `[A-ZÑ&]{3,4}\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])[A-Z\d]{2}[\dA]`
+	uruguayCIPattern      = `(?:\d\.?)?\d{3}\.?\d{3}-\d`
+)
+
+var (
+	cuitRegexp           = regexp.MustCompile(cuitPattern)
+	argentinaDNIRegexp   = regexp.MustCompile(argentinaDNIPattern)
+	rutRegexp            = regexp.MustCompile(rutPattern)
+	nitRegexp            = regexp.MustCompile(nitPattern)
+	colombiaCedulaRegexp = regexp.MustCompile(colombiaCedulaPattern)
+	curpRegexp           = regexp.MustCompile(curpPattern)
+	rfcRegexp            = regexp.MustCompile(rfcPattern)
+	uruguayCIRegexp      = regexp.MustCompile(uruguayCIPattern)
+)
+
+// argentinaDNIKeywords are the words that usually come with an Argentine DNI
+var argentinaDNIKeywords = []string{
+	"dni", "d.n.i", "documento", "documento nacional de identidad",
+}
+
+// colombiaCedulaKeywords are the words that usually come with a Colombian cédula
+var colombiaCedulaKeywords = []string{
+	"cédula", "cedula", "c.c", "cc", "cédula de ciudadanía", "cedula de ciudadania", "documento",
+}
+
+var (
+	// ArgentinaRuleSet provides a rule set of Argentine identification numbers
+	ArgentinaRuleSet = RuleSet{
+		"cuit_number": DefaultCUITRule,
+		"dni_number":  DefaultArgentinaDNIRule,
+	}
+
+	// ChileRuleSet provides a rule set of Chilean identification numbers
+	ChileRuleSet = RuleSet{
+		"rut_number": DefaultRUTRule,
+	}
+
+	// ColombiaRuleSet provides a rule set of Colombian identification numbers
+	ColombiaRuleSet = RuleSet{
+		"nit_number":    DefaultNITRule,
+		"cedula_number": DefaultColombiaCedulaRule,
+	}
+
+	// MexicoRuleSet provides a rule set of Mexican identification numbers
+	MexicoRuleSet = RuleSet{
+		"curp_number": DefaultCURPRule,
+		"rfc_number":  DefaultRFCRule,
+	}
+
+	// UruguayRuleSet provides a rule set of Uruguayan identification numbers
+	UruguayRuleSet = RuleSet{
+		"ci_number": DefaultUruguayCIRule,
+	}
+
+	// DefaultCUITRule is a default rule for Argentine CUIT/CUIL
+	DefaultCUITRule = Rule{
+		Name:        "argentina_CUIT",
+		Description: "Argentine CUIT/CUIL",
+		Severity:    3,
+		Filter:      CUIT(),
+		Locate:      RegexpLocator(cuitRegexp, CUIT()),
+	}
+
+	// DefaultArgentinaDNIRule is a default rule for Argentine DNI
+	DefaultArgentinaDNIRule = Rule{
+		Name:        "argentina_DNI",
+		Description: "Argentine DNI",
+		Severity:    3,
+		Filter:      ArgentinaDNI(),
+		Locate:      ArgentinaDNILocator(),
+	}
+
+	// DefaultRUTRule is a default rule for Chilean RUT/RUN
+	DefaultRUTRule = Rule{
+		Name:        "chile_RUT",
+		Description: "Chilean RUT/RUN",
+		Severity:    3,
+		Filter:      RUT(),
+		Locate:      RegexpLocator(rutRegexp, RUT()),
+	}
+
+	// DefaultNITRule is a default rule for Colombian NIT
+	DefaultNITRule = Rule{
+		Name:        "colombia_NIT",
+		Description: "Colombian NIT",
+		Severity:    3,
+		Filter:      NIT(),
+		Locate:      RegexpLocator(nitRegexp, NIT()),
+	}
+
+	// DefaultColombiaCedulaRule is a default rule for Colombian cédula de ciudadanía
+	DefaultColombiaCedulaRule = Rule{
+		Name:        "colombia_cedula",
+		Description: "Colombian cédula de ciudadanía",
+		Severity:    3,
+		Filter:      ColombiaCedula(),
+		Locate:      ColombiaCedulaLocator(),
+	}
+
+	// DefaultCURPRule is a default rule for Mexican CURP
+	DefaultCURPRule = Rule{
+		Name:        "mexico_CURP",
+		Description: "Mexican CURP",
+		Severity:    3,
+		Filter:      CURP(),
+		Locate:      RegexpLocator(curpRegexp, CURP()),
+	}
+
+	// DefaultRFCRule is a default rule for Mexican RFC
+	DefaultRFCRule = Rule{
+		Name:        "mexico_RFC",
+		Description: "Mexican RFC",
+		Severity:    3,
+		Filter:      RFC(),
+		Locate:      RegexpLocator(rfcRegexp, RFC()),
+	}
+
+	// DefaultUruguayCIRule is a default rule for Uruguayan cédula de identidad
+	DefaultUruguayCIRule = Rule{
+		Name:        "uruguay_CI",
+		Description: "Uruguayan cédula de identidad",
+		Severity:    3,
+		Filter:      UruguayCI(),
+		Locate:      RegexpLocator(uruguayCIRegexp, UruguayCI()),
+	}
+)
+
+// CUIT generates a matcher for identifying Argentine CUITs and CUILs,
+// validating their type prefix and check digit
+func CUIT() Matcher {
+	return Any(
+		matchCUIT,
+	)
+}
+
+// ArgentinaDNI generates a matcher for identifying Argentine DNIs. DNIs have
+// no check digit, so any number with 7 or 8 digits matches.
+func ArgentinaDNI() Matcher {
+	return Any(
+		matchArgentinaDNI,
+	)
+}
+
+// ArgentinaDNILocator generates a locator for Argentine DNIs that come after
+// or before a keyword such as "DNI"
+func ArgentinaDNILocator() Locator {
+	return WithContext(RegexpLocator(argentinaDNIRegexp, ArgentinaDNI()), 40, argentinaDNIKeywords...)
+}
+
+// RUT generates a matcher for identifying Chilean RUTs and RUNs, validating
+// their check digit
+func RUT() Matcher {
+	return Any(
+		matchRUT,
+	)
+}
+
+// NIT generates a matcher for identifying Colombian NITs, validating their
+// check digit
+func NIT() Matcher {
+	return Any(
+		matchNIT,
+	)
+}
+
+// ColombiaCedula generates a matcher for identifying Colombian cédulas de
+// ciudadanía. Cédulas have no check digit, so any number with 6 to 10 digits
+// matches.
+func ColombiaCedula() Matcher {
+	return Any(
+		matchColombiaCedula,
+	)
+}
+
+// ColombiaCedulaLocator generates a locator for Colombian cédulas that come
+// after or before a keyword such as "cédula"
+func ColombiaCedulaLocator() Locator {
+	return WithContext(RegexpLocator(colombiaCedulaRegexp, ColombiaCedula()), 40, colombiaCedulaKeywords...)
+}
+
+// CURP generates a matcher for identifying Mexican CURPs, validating their
+// birth date, state and check digit
+func CURP() Matcher {
+	return Any(
+		matchCURP,
+	)
+}
+
+// RFC generates a matcher for identifying Mexican RFCs of people and
+// companies, validating their check character
+func RFC() Matcher {
+	return Any(
+		matchRFC,
+	)
+}
+
+// UruguayCI generates a matcher for identifying Uruguayan cédulas de
+// identidad, validating their check digit
+func UruguayCI() Matcher {
+	return Any(
+		matchUruguayCI,
+	)
+}
+
+// matchCUIT returns an Argentine CUIT/CUIL match in the "20-12345678-6"
+// format, or unformatted. The prefix tells people (20, 23, 24, 27) from
+// companies (30, 33, 34), and the check digit is 11 minus the weighted sum of
+// the other digits modulo 11.
+func matchCUIT(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(cuitRegexp, s) || strings.Count(s, "-")%2 != 0 {
+		return false
+	}
+	s = strings.ReplaceAll(s, "-", "")
+
+	switch s[:2] {
+	case "20", "23", "24", "27", "30", "33", "34":
+	default:
+		return false
+	}
+
+	checkDigit := 11 - sumDigit(s[:10], []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2})%11
+	switch checkDigit {
+	case 11:
+		checkDigit = 0
+	case 10:
+		return false
+	}
+
+	return int(s[10]-'0') == checkDigit
+}
+
+// matchArgentinaDNI returns an Argentine DNI match with 7 or 8 digits, such as
+// "12.345.678" or "12345678"
+func matchArgentinaDNI(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(argentinaDNIRegexp, s) || strings.Count(s, ".")%2 != 0 {
+		return false
+	}
+	s = strings.ReplaceAll(s, ".", "")
+
+	return !allSameDigit(s)
+}
+
+// matchRUT returns a Chilean RUT/RUN match in the "12.345.678-5" format, or
+// without dots. The digits are weighted from 2 to 7 from the right, and the
+// check digit is 11 minus their sum modulo 11, where 10 is written as K.
+func matchRUT(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(rutRegexp, s) || strings.Count(s, ".")%2 != 0 {
+		return false
+	}
+	s = strings.ToUpper(strings.NewReplacer(".", "", "-", "").Replace(s))
+
+	body := s[:len(s)-1]
+	sum, weight := 0, 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * weight
+		if weight++; weight > 7 {
+			weight = 2
+		}
+	}
+
+	var checkDigit string
+	switch digit := 11 - sum%11; digit {
+	case 11:
+		checkDigit = "0"
+	case 10:
+		checkDigit = "K"
+	default:
+		checkDigit = strconv.Itoa(digit)
+	}
+
+	return s[len(s)-1:] == checkDigit
+}
+
+// matchNIT returns a Colombian NIT match in the "800.197.268-4" format, or
+// without dots. The digits are weighted by primes from the right, and the
+// check digit is 11 minus their sum modulo 11 when that sum is above 1.
+func matchNIT(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(nitRegexp, s) || strings.Count(s, ".")%2 != 0 {
+		return false
+	}
+	s = strings.NewReplacer(".", "", "-", "").Replace(s)
+
+	checkDigit := sumDigit(s[:9], []int{41, 37, 29, 23, 19, 17, 13, 7, 3}) % 11
+	if checkDigit > 1 {
+		checkDigit = 11 - checkDigit
+	}
+
+	return int(s[9]-'0') == checkDigit
+}
+
+// matchColombiaCedula returns a Colombian cédula match with 6 to 10 digits,
+// such as "1.234.567.890" or "1234567890"
+func matchColombiaCedula(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(colombiaCedulaRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, ".", "")
+
+	if len(s) < 6 || len(s) > 10 {
+		return false
+	}
+
+	return !allSameDigit(s)
+}
+
+// curpAlphabet gives the value of each CURP character by its position
+var curpAlphabet = []rune("0123456789ABCDEFGHIJKLMNÑOPQRSTUVWXYZ")
+
+// matchCURP returns a Mexican CURP match with 18 characters. The first 17 are
+// weighted from 18 down to 2 by their position within curpAlphabet, and the
+// check digit is 10 minus their sum modulo 10.
+func matchCURP(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(curpRegexp, s) {
+		return false
+	}
+
+	sum := 0
+	for i, r := range s[:17] {
+		sum += runeIndex(curpAlphabet, r) * (18 - i)
+	}
+	checkDigit := (10 - sum%10) % 10
+
+	return int(s[17]-'0') == checkDigit
+}
+
+// rfcAlphabet gives the value of each RFC character by its position
+var rfcAlphabet = []rune("0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ")
+
+// matchRFC returns a Mexican RFC match, with 13 characters for people and 12
+// for companies. Company RFCs are padded by a space, and the first 12
+// characters are weighted from 13 down to 2 by their position within
+// rfcAlphabet. The check character is 11 minus their sum modulo 11, where 10
+// is written as A.
+func matchRFC(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(rfcRegexp, s) {
+		return false
+	}
+
+	chars := []rune(s)
+	if len(chars) == 12 {
+		chars = append([]rune{' '}, chars...)
+	}
+
+	sum := 0
+	for i, r := range chars[:12] {
+		sum += runeIndex(rfcAlphabet, r) * (13 - i)
+	}
+
+	var checkDigit rune
+	switch digit := 11 - sum%11; digit {
+	case 11:
+		checkDigit = '0'
+	case 10:
+		checkDigit = 'A'
+	default:
+		checkDigit = rune('0' + digit)
+	}
+
+	return chars[12] == checkDigit
+}
+
+// runeIndex returns the position of r within runes, or -1
+func runeIndex(runes []rune, r rune) int {
+	for i, x := range runes {
+		if x == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// matchUruguayCI returns a Uruguayan cédula de identidad match in the
+// "1.234.567-2" format, or without dots. The seven digits, padded with zeros,
+// are weighted by 2, 9, 8, 7, 6, 3 and 4, and the check digit is 10 minus their
+// sum modulo 10.
+func matchUruguayCI(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(uruguayCIRegexp, s) {
+		return false
+	}
+	s = strings.NewReplacer(".", "", "-", "").Replace(s)
+	s = strings.Repeat("0", 8-len(s)) + s
+
+	checkDigit := (10 - sumDigit(s[:7], []int{2, 9, 8, 7, 6, 3, 4})%10) % 10
+
+	return int(s[7]-'0') == checkDigit
+}
@@ -0,0 +1,191 @@
+package leakspok
+
+import "testing"
+
+func TestMatchCUIT(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"20-12345678-6", true},
+		{"27-34567890-0", true},
+		{"30-71234567-1", true},
+		{"20412345674", true},
+		{"\"20-12345678-6\",", true},
+		{"20-12345678-5", false},
+		{"21-12345678-6", false},
+		{"20-12345678", false},
+		{"20-123456786", false},
+	}
+
+	for _, test := range tests {
+		if got := matchCUIT(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchRUT(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"12.345.678-5", true},
+		{"7.654.321-6", true},
+		{"10.000.013-K", true},
+		{"10000013-k", true},
+		{"76.086.428-5", true},
+		{"5126663-3", true},
+		{"12.345.678-4", false},
+		{"10.000.013-0", false},
+		{"12.345678-5", false},
+		{"123456785", false},
+	}
+
+	for _, test := range tests {
+		if got := matchRUT(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchNIT(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"900.123.456-8", true},
+		{"800.197.268-4", true},
+		{"860034313-7", true},
+		{"890.903.938-8", true},
+		{"900.123.456-7", false},
+		{"900.123456-8", false},
+		{"9001234568", false},
+	}
+
+	for _, test := range tests {
+		if got := matchNIT(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchCURP(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"GOMC850101HDFNRR00", true},
+		{"BADD110313HCMLNS06", true},
+		{"PEGJ900512MJCRRN02", true},
+		{"GOMC850101HDFNRR01", false},
+		{"GOMC851301HDFNRR00", false},
+		{"GOMC850101HZZNRR00", false},
+		{"GOMC850101ADFNRR00", false},
+	}
+
+	for _, test := range tests {
+		if got := matchCURP(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchRFC(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"GODE561231GR8", true},
+		{"VECJ880326XX1", true},
+		{"ABC680524P73", true},
+		{"GODE561231GR9", false},
+		{"GODE561331GR8", false},
+		{"ABC680524P74", false},
+		{"GODE561231", false},
+	}
+
+	for _, test := range tests {
+		if got := matchRFC(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchUruguayCI(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"1.234.567-2", true},
+		{"4.567.890-5", true},
+		{"1234567-2", true},
+		{"123.456-1", true},
+		{"1.234.567-3", false},
+		{"12345672", false},
+	}
+
+	for _, test := range tests {
+		if got := matchUruguayCI(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestArgentinaDNILocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"DNI 12.345.678", []string{"12.345.678"}},
+		{"documento: 7654321", []string{"7654321"}},
+		{"DNI 11.111.111", nil},
+		{"pedido 12.345.678", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(ArgentinaDNILocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestColombiaCedulaLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"cédula de ciudadanía 1.020.304.050", []string{"1.020.304.050"}},
+		{"C.C. 79123456 de Bogotá", []string{"79123456"}},
+		{"cedula 12345", nil},
+		{"factura 79123456", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(ColombiaCedulaLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestFindAllLatinAmerica(t *testing.T) {
+	tests := []struct {
+		set    RuleSet
+		input  string
+		expect string
+	}{
+		{ArgentinaRuleSet, "CUIT 20-12345678-6", "argentina_CUIT"},
+		{ChileRuleSet, "RUT: 12.345.678-5", "chile_RUT"},
+		{ColombiaRuleSet, "NIT 800.197.268-4", "colombia_NIT"},
+		{MexicoRuleSet, "CURP GOMC850101HDFNRR00", "mexico_CURP"},
+		{MexicoRuleSet, "RFC: GODE561231GR8.", "mexico_RFC"},
+		{UruguayRuleSet, "CI 1.234.567-2", "uruguay_CI"},
+	}
+
+	for _, test := range tests {
+		findings := NewStringTester(test.set).FindAll(test.input)
+		if len(findings) != 1 || findings[0].Rule.Name != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, findings)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package leakspok
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+)
+
+// ruleSets registers the built-in rule sets by name
+var ruleSets = map[string]RuleSet{
+	"default":     DefaultRuleSet,
+	"brazil":      BrazilianRuleSet,
+	"vehicle":     VehicleRuleSet,
+	"us":          USRuleSet,
+	"banking":     BankingRuleSet,
+	"argentina":   ArgentinaRuleSet,
+	"chile":       ChileRuleSet,
+	"colombia":    ColombiaRuleSet,
+	"mexico":      MexicoRuleSet,
+	"uruguay":     UruguayRuleSet,
+	"spain":       SpainRuleSet,
+	"portugal":    PortugalRuleSet,
+	"italy":       ItalyRuleSet,
+	"france":      FranceRuleSet,
+	"germany":     GermanyRuleSet,
+	"netherlands": NetherlandsRuleSet,
+	"uk":          UKRuleSet,
+	"india":       IndiaRuleSet,
+	"singapore":   SingaporeRuleSet,
+	"australia":   AustraliaRuleSet,
+	"japan":       JapanRuleSet,
+	"travel":      TravelRuleSet,
+	"secrets":     SecretsRuleSet,
+	"entropy":     EntropyRuleSet,
+}
+
+// RuleSetNames returns the names of all built-in rule sets, sorted
+func RuleSetNames() []string {
+	names := make([]string, 0, len(ruleSets))
+	for name := range ruleSets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupRuleSet returns the built-in rule set registered under name
+func LookupRuleSet(name string) (RuleSet, error) {
+	set, ok := ruleSets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown rule set %q", name)
+	}
+	return set, nil
+}
+
+// lookupRule returns the built-in rule with the given Rule.Name
+func lookupRule(name string) (Rule, bool) {
+	for _, setName := range RuleSetNames() {
+		for _, rule := range ruleSets[setName] {
+			if rule.Name == name {
+				return rule, true
+			}
+		}
+	}
+	return Rule{}, false
+}
+
+// ruleFile is the on-disk format read by LoadRuleSet
+type ruleFile struct {
+	Rules []json.RawMessage `json:"rules"`
+}
+
+// ruleSource selects the matcher of a rule within a rule file
+type ruleSource struct {
+	Name    string `json:"name"`
+	Builtin string `json:"builtin"`
+	Pattern string `json:"pattern"`
+}
+
+// LoadRuleSet reads a JSON rule file. Each rule either sets a "pattern"
+// regular expression or refers to a built-in rule by "builtin" (or by its
+// "name" when "builtin" is omitted). Any other Rule field given in the file
+// overrides the built-in value:
+//
+//	{"rules": [
+//	  {"name": "brazilian_CPF", "severity": 5},
+//	  {"name": "employee_id", "description": "employee id", "severity": 2, "pattern": "EMP-[0-9]{6}"}
+//	]}
+func LoadRuleSet(r io.Reader) (RuleSet, error) {
+	var file ruleFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("error on parsing rule file: %w", err)
+	}
+
+	set := RuleSet{}
+	for i, raw := range file.Rules {
+		rule, err := parseRule(raw)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		if _, ok := set[rule.Name]; ok {
+			return nil, fmt.Errorf("rule %d: duplicated rule name %q", i+1, rule.Name)
+		}
+		set[rule.Name] = rule
+	}
+
+	return set, nil
+}
+
+// parseRule builds a single rule from its JSON definition
+func parseRule(raw json.RawMessage) (Rule, error) {
+	var src ruleSource
+	if err := json.Unmarshal(raw, &src); err != nil {
+		return Rule{}, err
+	}
+
+	var rule Rule
+	switch {
+	case src.Pattern != "":
+		re, err := regexp.Compile(src.Pattern)
+		if err != nil {
+			return Rule{}, fmt.Errorf("invalid pattern: %w", err)
+		}
+		rule.Filter = re.MatchString
+	case src.Builtin != "" || src.Name != "":
+		name := src.Builtin
+		if name == "" {
+			name = src.Name
+		}
+		builtin, ok := lookupRule(name)
+		if !ok {
+			return Rule{}, fmt.Errorf("unknown builtin rule %q", name)
+		}
+		rule = builtin
+	default:
+		return Rule{}, fmt.Errorf("rule must define a name, a builtin or a pattern")
+	}
+
+	// Fields present in the file override the built-in ones
+	if err := json.Unmarshal(raw, &rule); err != nil {
+		return Rule{}, err
+	}
+	if rule.Name == "" {
+		return Rule{}, fmt.Errorf("rule must define a name")
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,74 @@
+package leakspok
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadRuleSet(t *testing.T) {
+	input := `{"rules": [
+		{"name": "brazilian_CPF", "severity": 5},
+		{"name": "cnpj", "builtin": "brazilian_CNPJ", "redact": true},
+		{"name": "employee_id", "description": "employee id", "severity": 2, "pattern": "^EMP-[0-9]{6}$"}
+	]}`
+
+	set, err := LoadRuleSet(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(set) != 3 {
+		t.Fatalf("Expected 3 rules but got %d", len(set))
+	}
+
+	cpf := set["brazilian_CPF"]
+	if cpf.Severity != 5 || cpf.Description != DefaultCPFRule.Description || !cpf.Filter("111.444.777-35") {
+		t.Errorf("Expected the builtin CPF rule with severity 5 but got %+v", cpf)
+	}
+
+	cnpj := set["cnpj"]
+	if !cnpj.Anonymize || !cnpj.Filter("11.444.777/0001-61") {
+		t.Errorf("Expected the builtin CNPJ rule with anonymization but got %+v", cnpj)
+	}
+
+	employee := set["employee_id"]
+	if !employee.Filter("EMP-123456") || employee.Filter("EMP-12345") {
+		t.Errorf("Unexpected matches for the employee_id pattern")
+	}
+}
+
+func TestLoadRuleSetErrors(t *testing.T) {
+	tests := []string{
+		`{"rules": [{"name": "unknown_rule"}]}`,
+		`{"rules": [{"pattern": "[0-9]+"}]}`,
+		`{"rules": [{"name": "bad", "pattern": "[0-9"}]}`,
+		`{"rules": [{"name": "brazilian_CPF"}, {"name": "brazilian_CPF"}]}`,
+		`{"rules": [{}]}`,
+		`not json`,
+	}
+
+	for _, input := range tests {
+		if _, err := LoadRuleSet(strings.NewReader(input)); err == nil {
+			t.Errorf("For input %q expected an error", input)
+		}
+	}
+}
+
+func TestLookupRuleSet(t *testing.T) {
+	set, err := LookupRuleSet("default")
+	if err != nil || len(set) != len(DefaultRuleSet) {
+		t.Errorf("Expected the default rule set but got %v, %v", set, err)
+	}
+
+	// IBANs are opt-in, so default callers keep their results
+	if _, ok := set["iban"]; ok {
+		t.Errorf("Expected the default rule set without IBANs")
+	}
+	if set, err := LookupRuleSet("banking"); err != nil || set["iban"].Name != "iban" {
+		t.Errorf("Expected the banking rule set with IBANs but got %v, %v", set, err)
+	}
+
+	if _, err := LookupRuleSet("unknown"); err == nil {
+		t.Errorf("Expected an error for an unknown rule set")
+	}
+}
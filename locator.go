@@ -0,0 +1,163 @@
+package leakspok
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locator returns the byte ranges [start, end) of the matches within s. Rules
+// with a Locator use it instead of calling their Filter on every field, so
+// they can match text spanning several fields or depending on its context.
+type Locator func(s string) [][]int
+
+// RegexpLocator returns a Locator for the matches of re that are not part of
+// a longer word or number and, when m is not nil, that satisfy m
+func RegexpLocator(re *regexp.Regexp, m Matcher) Locator {
+	return func(s string) [][]int {
+		var locs [][]int
+		for _, loc := range re.FindAllStringIndex(s, -1) {
+			if !isStandalone(s, loc[0], loc[1]) {
+				continue
+			}
+			if m != nil && !m(s[loc[0]:loc[1]]) {
+				continue
+			}
+			locs = append(locs, loc)
+		}
+		return locs
+	}
+}
+
+// WithContext returns a Locator keeping the matches of l that have one of
+// the keywords within window bytes before or after them, on the same line.
+// Keywords are matched as whole words, ignoring case.
+func WithContext(l Locator, window int, keywords ...string) Locator {
+	return func(s string) [][]int {
+		var locs [][]int
+		for _, loc := range l(s) {
+			if hasContext(s, loc, window, keywords) {
+				locs = append(locs, loc)
+			}
+		}
+		return locs
+	}
+}
+
+// WithContextMatch returns a Locator keeping the matches of l whose text
+// within window bytes before or after them, on the same line, satisfies m
+func WithContextMatch(l Locator, window int, m Matcher) Locator {
+	return func(s string) [][]int {
+		var locs [][]int
+		for _, loc := range l(s) {
+			before, after := surroundings(s, loc, window)
+			if m(before) || m(after) {
+				locs = append(locs, loc)
+			}
+		}
+		return locs
+	}
+}
+
+// hasContext reports whether one of the keywords is within window bytes before
+// or after the match at loc, on the same line
+func hasContext(s string, loc []int, window int, keywords []string) bool {
+	before, after := surroundings(s, loc, window)
+	return containsKeyword(before, keywords) || containsKeyword(after, keywords)
+}
+
+// surroundings returns the text within window bytes before and after the
+// match at loc, without crossing line breaks
+func surroundings(s string, loc []int, window int) (string, string) {
+	before := s[max0(loc[0]-window):loc[0]]
+	before = before[strings.LastIndexByte(before, '\n')+1:]
+	after := s[loc[1]:minLen(loc[1]+window, len(s))]
+	if i := strings.IndexByte(after, '\n'); i >= 0 {
+		after = after[:i]
+	}
+	return before, after
+}
+
+// AnyLocator returns a Locator for the matches of all locators, ordered by
+// position. Overlapping matches are merged into the longest one.
+func AnyLocator(locators ...Locator) Locator {
+	return func(s string) [][]int {
+		var all [][]int
+		for _, l := range locators {
+			all = append(all, l(s)...)
+		}
+		sort.Slice(all, func(i, j int) bool {
+			if all[i][0] != all[j][0] {
+				return all[i][0] < all[j][0]
+			}
+			return all[i][1] > all[j][1]
+		})
+
+		var locs [][]int
+		for _, loc := range all {
+			if n := len(locs); n > 0 && loc[0] < locs[n-1][1] {
+				if loc[1] > locs[n-1][1] {
+					locs[n-1][1] = loc[1]
+				}
+				continue
+			}
+			locs = append(locs, []int{loc[0], loc[1]})
+		}
+		return locs
+	}
+}
+
+// isStandalone reports whether s[start:end] is not glued to letters or
+// digits, which would make it part of a longer word or number
+func isStandalone(s string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(s[:start])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	if end < len(s) {
+		r, _ := utf8.DecodeRuneInString(s[end:])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsKeyword reports whether any of the keywords is a whole word of s,
+// ignoring case
+func containsKeyword(s string, keywords []string) bool {
+	s = strings.ToLower(s)
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(keyword)
+		for offset := 0; ; {
+			i := strings.Index(s[offset:], keyword)
+			if i < 0 {
+				break
+			}
+			start := offset + i
+			if isStandalone(s, start, start+len(keyword)) {
+				return true
+			}
+			offset = start + len(keyword)
+		}
+	}
+	return false
+}
+
+func max0(n int) int {
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+func minLen(n, length int) int {
+	if n > length {
+		return length
+	}
+	return n
+}
@@ -0,0 +1,302 @@
+package leakspok
+
+import (
+	"regexp"
+	"testing"
+)
+
+// locatedStrings returns the substrings of s at the ranges found by l
+func locatedStrings(l Locator, s string) []string {
+	var got []string
+	for _, loc := range l(s) {
+		got = append(got, s[loc[0]:loc[1]])
+	}
+	return got
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRegexpLocator(t *testing.T) {
+	l := RegexpLocator(regexp.MustCompile(`\d{3}`), func(s string) bool { return s != "000" })
+
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"123 456", []string{"123", "456"}},
+		{"(123), 000, 1234, a123, 123b, ção123", []string{"123"}},
+		{"", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(l, test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	l := WithContext(RegexpLocator(regexp.MustCompile(`\d{3}`), nil), 16, "code", "órgão")
+
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"code: 123 and 456", []string{"123", "456"}},
+		{"CODE 123", []string{"123"}},
+		{"123 is the code", []string{"123"}},
+		{"ÓRGÃO 123", []string{"123"}},
+		{"barcode 123", nil},
+		{"the code is very far away from 123", nil},
+		{"code\n123", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(l, test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestAnyLocator(t *testing.T) {
+	l := AnyLocator(
+		RegexpLocator(regexp.MustCompile(`\d+-\d+`), nil),
+		RegexpLocator(regexp.MustCompile(`\d+`), nil),
+	)
+
+	input := "12-34 56"
+	expect := []string{"12-34", "56"}
+	if got := locatedStrings(l, input); !equalStrings(got, expect) {
+		t.Errorf("For input %q expected %q but got %q", input, expect, got)
+	}
+}
+
+func TestRGLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"documento 12.345.678-2 emitido", []string{"12.345.678-2"}},
+		{"RG: 12.345.678-3 SSP/RJ", []string{"12.345.678-3"}},
+		{"identidade MG-12.345.678", []string{"MG-12.345.678"}},
+		{"documento SP-12.345.678-2 emitido", []string{"SP-12.345.678-2"}},
+		{"pedido RJ-12.345.678-2", nil},
+		{"rg 123456789", []string{"123456789"}},
+		{"pedido 12.345.678-3", nil},
+		{"pedido MG-12.345.678", nil},
+		{"pedido 123456782", nil},
+		{"RG/CPF 111.444.777-35", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(RGLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestTituloEleitorLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"título 0043 5687 0906 zona 12", []string{"0043 5687 0906"}},
+		{"eleitor: 004356870906.", []string{"004356870906"}},
+		{"pedido 0043 5687 0907", nil},
+		{"telefone 1234 5678 9012", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(TituloEleitorLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestCNSLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"cartão SUS 702 0028 8742 9583", []string{"702 0028 8742 9583"}},
+		{"cns=702002887429583;", []string{"702002887429583"}},
+		{"pedido 702 0028 8742 9584", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(CNSLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestCNPJLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"empresa 11.444.777/0001-61 ativa", []string{"11.444.777/0001-61"}},
+		{`{"cnpj": "11444777000161"}`, []string{"11444777000161"}},
+		{"empresa 12.ABC.345/01DE-35 ativa", []string{"12.ABC.345/01DE-35"}},
+		{"empresa 12ABC34501DE35", []string{"12ABC34501DE35"}},
+		{"CNPJ: 12ABC34501DE35", []string{"12ABC34501DE35"}},
+		{"order 12ABC34501DE35 shipped", nil},
+		{"order ABCDEFGHIJKL80 shipped", nil},
+		{"server HTTPSERVER0101 started", nil},
+		{"item PRODUCTCODE142 AAAAAAAAAAAA45", nil},
+		{"pedido 12.ABC.345/01DE-35 enviado", []string{"12.ABC.345/01DE-35"}},
+		{"pedido 11444777000161 enviado", []string{"11444777000161"}},
+		{"empresa 12.ABC.345/01DE-36", nil},
+		{"id X12ABC34501DE35", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(CNPJLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestBoletoLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"segue o boleto: 23791.23017 60000.000053 25000.456704 2 84410000026000, obrigado",
+			[]string{"23791.23017 60000.000053 25000.456704 2 84410000026000"}},
+		{"conta de luz 83670000000-0 11331201380-9 00812884627-9 10801361815-3",
+			[]string{"83670000000-0 11331201380-9 00812884627-9 10801361815-3"}},
+		{"boleto 23791.23017 60000.000053 25000.456704 3 84410000026000", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(BoletoLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestBrazilianPhoneLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"ligue (11) 98765-4321 amanhã", []string{"(11) 98765-4321"}},
+		{"contato: +5511987654321", []string{"+5511987654321"}},
+		{"whatsapp 11987654321", []string{"11987654321"}},
+		{"pedido 11987654321", nil},
+		{"ligue (10) 98765-4321", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(BrazilianPhoneLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestBrazilianAddressLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"entregar na Rua Augusta, 1500 - São Paulo, 01310-100", []string{"Rua Augusta, 1500"}},
+		{"Av. Paulista, nº 1578, apto 12", []string{"Av. Paulista, nº 1578"}},
+		{"Rua Augusta, 1500\n01310-100", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(BrazilianAddressLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestCEPLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"São Paulo - SP, 01310-100", []string{"01310-100"}},
+		{"CEP: 01310100", []string{"01310100"}},
+		{"pedido 01310100", nil},
+		{"código 00999-000", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(CEPLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestPlacaLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"carro BRA2E19 estacionado", []string{"BRA2E19"}},
+		{"placa ABC-1234", []string{"ABC-1234"}},
+		{"veículo de placa ABC1234", []string{"ABC1234"}},
+		{"plate: ABC-1234", []string{"ABC-1234"}},
+		{"certified ISO-9001", nil},
+		{"see RFC-7231 and SHA-2560", nil},
+		{"pedido ABC-1234", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(PlacaLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestRENAVAMLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"RENAVAM: 00639884962", []string{"00639884962"}},
+		{"veículo placa BRA2E19 renavam 00639884962", []string{"00639884962"}},
+		{"pedido 00639884962", nil},
+		{"renavam 00639884963", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(RENAVAMLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestIBANLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"IBAN: DE89 3704 0044 0532 0130 00 (EUR)", []string{"DE89 3704 0044 0532 0130 00"}},
+		{"pay to NL91ABNA0417164300.", []string{"NL91ABNA0417164300"}},
+		{"iban BE68 5390 0754 7034 EUR", []string{"BE68 5390 0754 7034"}},
+		{"iban be68539007547034", []string{"be68539007547034"}},
+		{"iban SA0380000000608010167519", []string{"SA0380000000608010167519"}},
+		{"iban SA03 8000 0000 6080 1016 7519", []string{"SA03 8000 0000 6080 1016 7519"}},
+		{"iban BE68539007547034EUR", nil},
+		{"order OR12 3456 7890 1234", nil},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(IBANLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package leakspok
 
+import (
+	"sort"
+	"strings"
+)
+
 // Matcher is an evaluation type
 type Matcher func(string) bool
 
@@ -99,6 +104,25 @@ func SSN() Matcher {
 	)
 }
 
+// ITIN returns a matcher for identifying US individual taxpayer identification numbers
+func ITIN() Matcher {
+	return And(
+		matchitin,
+		Not(matchfilename),
+	)
+}
+
+// EIN returns a matcher for identifying US employer identification numbers
+func EIN() Matcher {
+	return And(
+		matchein,
+		All(
+			Not(matchfilename),
+			Not(matchrepeatingnumber),
+		),
+	)
+}
+
 // Email returns a matcher for identifying email addresses
 func Email() Matcher {
 	return matchemail
@@ -152,16 +176,120 @@ func Address() Matcher {
 	)
 }
 
-// BankInfo returns a matcher for identifying either IBANs or US Routing #s
-func BankInfo() Matcher {
+// BrazilianAddress returns a matcher for identifying Brazilian street
+// addresses: a logradouro such as "Rua Augusta, 1500" along with a valid CEP or
+// a complement such as "apto 12" or "bairro"
+func BrazilianAddress() Matcher {
 	return And(
+		matchlogradouro,
 		Any(
-			matchiban,
+			containsCEP,
+			matchcomplement,
 		),
-		Not(matchrepeatingnumber),
 	)
 }
 
+// BrazilianAddressLocator generates a locator for the logradouros of Brazilian
+// street addresses that have a valid CEP or a complement on the same line
+func BrazilianAddressLocator() Locator {
+	return WithContextMatch(RegexpLocator(logradouroRegexp, nil), 80, Any(containsCEP, matchcomplement))
+}
+
+// CEP generates a matcher for identifying Brazilian postal codes, validating
+// that they are within the range of a state
+func CEP() Matcher {
+	return Any(
+		matchCEP,
+	)
+}
+
+// CEPLocator generates a locator for Brazilian postal codes. Unformatted CEPs
+// look like any other 8 digits, so they are only found after or before a
+// keyword such as "CEP".
+func CEPLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(cepRegexp, matchFormattedCEP),
+		WithContext(RegexpLocator(cepRegexp, CEP()), 40, cepKeywords...),
+	)
+}
+
+// DescribeCEP generates a describer reporting the state of a CEP
+func DescribeCEP() Describer {
+	return func(s string, loc []int) map[string]string {
+		return map[string]string{"state": cepState(s[loc[0]:loc[1]])}
+	}
+}
+
+// BankInfo returns a matcher for identifying IBANs, validating their mod-97
+// checksum and the length registered for their country. Long runs of zeros,
+// as in "SA03 8000 0000 6080 1016 7519", are common in valid IBANs.
+func BankInfo() Matcher {
+	return Any(
+		matchiban,
+	)
+}
+
+// IBANLocator generates a locator for IBANs, in either case, including the
+// ones grouped by four characters such as "DE89 3704 0044 0532 0130 00". Each
+// match is cut at the length registered for its country.
+func IBANLocator() Locator {
+	m := BankInfo()
+	return func(s string) [][]int {
+		var locs [][]int
+		for _, loc := range ibanRegexp.FindAllStringIndex(s, -1) {
+			loc[1] = loc[0] + ibanEnd(s[loc[0]:loc[1]])
+			if !isStandalone(s, loc[0], loc[1]) || !m(s[loc[0]:loc[1]]) {
+				continue
+			}
+			locs = append(locs, loc)
+		}
+		return locs
+	}
+}
+
+// DescribeIBAN generates a describer reporting the country code of an IBAN
+func DescribeIBAN() Describer {
+	return func(s string, loc []int) map[string]string {
+		return map[string]string{"country": ibanCountry(s[loc[0]:loc[1]])}
+	}
+}
+
+// BrazilianBankAccount returns a matcher for identifying Brazilian agency and
+// account numbers, such as "ag 1234-3 cc 12345-5", whose check digits are valid
+// for Banco do Brasil, Bradesco, Itaú or Caixa
+func BrazilianBankAccount() Matcher {
+	return Any(
+		matchBrazilianBankAccount,
+	)
+}
+
+// BrazilianBankAccountLocator generates a locator for Brazilian agency and
+// account numbers
+func BrazilianBankAccountLocator() Locator {
+	return RegexpLocator(bankAccountRegexp, BrazilianBankAccount())
+}
+
+// DescribeBrazilianBank generates a describer reporting the banks whose check
+// digits validate an agency and account. When several banks do, the ones whose
+// code or name come with the match are preferred.
+func DescribeBrazilianBank() Describer {
+	return func(s string, loc []int) map[string]string {
+		banks := bankAccountBanks(s[loc[0]:loc[1]])
+		if len(banks) > 1 {
+			var mentioned []string
+			for _, bank := range brazilianBanks {
+				if containsString(banks, bank.name) && hasContext(s, loc, 80, bank.keywords) {
+					mentioned = append(mentioned, bank.name)
+				}
+			}
+			if len(mentioned) > 0 {
+				banks = mentioned
+			}
+		}
+		return map[string]string{"bank": strings.Join(banks, ",")}
+	}
+}
+
 // UUID returns a matcher for identifying GUIDs, UUIDs, v3, v4, and v5
 func UUID() Matcher {
 	return And(
@@ -188,6 +316,51 @@ func VIN() Matcher {
 	)
 }
 
+// Placa generates a matcher for identifying Brazilian license plates, in both
+// the old "ABC-1234" and the Mercosul "ABC1D23" formats
+func Placa() Matcher {
+	return All(
+		matchPlaca,
+		Not(matchfilename),
+	)
+}
+
+// PlacaMercosul generates a matcher for identifying Brazilian license plates
+// in the Mercosul "ABC1D23" format only, which, unlike the old "ABC-1234" one,
+// doesn't look like other codes without a keyword
+func PlacaMercosul() Matcher {
+	return All(
+		matchPlacaMercosul,
+		Not(matchfilename),
+	)
+}
+
+// PlacaLocator generates a locator for Brazilian license plates. Plates in the
+// Mercosul format are always found; as the old "ABC-1234" format looks like
+// many codes, such as "ISO-9001", those plates are only found after or before
+// a keyword such as "placa" or "veículo".
+func PlacaLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(placaMercosulRegexp, Placa()),
+		WithContext(RegexpLocator(placaRegexp, Placa()), 40, placaKeywords...),
+	)
+}
+
+// RENAVAM generates a matcher for identifying Brazilian vehicle registration
+// numbers, validating their check digit
+func RENAVAM() Matcher {
+	return Any(
+		matchRENAVAM,
+	)
+}
+
+// RENAVAMLocator generates a locator for RENAVAM numbers. As they look like
+// any other 11 digits, including CPFs, they are only found after or before a
+// keyword such as "RENAVAM" or "veículo".
+func RENAVAMLocator() Locator {
+	return WithContext(RegexpLocator(renavamRegexp, RENAVAM()), 40, renavamKeywords...)
+}
+
 // HaltLangDetect is a special matcher for preventing language detection from running
 func HaltLangDetect() Matcher {
 	return Any(
@@ -212,6 +385,190 @@ func CNPJ() Matcher {
 	)
 }
 
+// CNPJLocator generates a locator for Brazilian CNPJs, either numeric or
+// alphanumeric, with or without the "XX.XXX.XXX/XXXX-XX" punctuation.
+// Alphanumeric CNPJs without punctuation look like any other code, so they are
+// only found when they come after or before a keyword such as "cnpj".
+func CNPJLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(cnpjLocateRegexp, And(CNPJ(), Not(matchBareAlphanumericCNPJ))),
+		WithContext(RegexpLocator(cnpjLocateRegexp, CNPJ()), 40, cnpjKeywords...),
+	)
+}
+
+// RG generates a matcher for identifying Brazilian RGs in the "12.345.678-9"
+// format, validating the check digit of their state. Numbers without a state
+// prefix are checked as São Paulo ones; RGs from states without a published
+// check digit, such as MG, are only found by RGLocator next to a keyword.
+func RG() Matcher {
+	return Any(
+		matchRG,
+	)
+}
+
+// RGLocator generates a locator for Brazilian RGs. Besides the São Paulo
+// numbers validated by RG, it finds the RGs of every other state, such as
+// "MG-12.345.678" or an RJ number with an unchecked digit, only when they come
+// after or before a keyword such as "RG" or "identidade".
+func RGLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(rgRegexp, RG()),
+		WithContext(RegexpLocator(rgRegexp, nil), 40, rgKeywords...),
+	)
+}
+
+// CNH generates a matcher for identifying Brazilian CNHs (driver's licenses),
+// validating their check digits
+func CNH() Matcher {
+	return Any(
+		matchCNH,
+	)
+}
+
+// CNHLocator generates a locator for Brazilian CNHs. As CNHs and CPFs are both
+// 11 digits long, numbers that are also valid CPFs are only found when they
+// come after or before a keyword such as "CNH" or "habilitação".
+func CNHLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(cnhRegexp, And(CNH(), Not(CPF()))),
+		WithContext(RegexpLocator(cnhRegexp, CNH()), 40, cnhKeywords...),
+	)
+}
+
+// PIS generates a matcher for identifying Brazilian PIS/PASEP/NIT/NIS
+// numbers, validating their check digit
+func PIS() Matcher {
+	return Any(
+		matchPIS,
+	)
+}
+
+// TituloEleitor generates a matcher for identifying Brazilian voter IDs
+// (títulos de eleitor), validating their state code and check digits
+func TituloEleitor() Matcher {
+	return Any(
+		matchTituloEleitor,
+	)
+}
+
+// TituloEleitorLocator generates a locator for Brazilian voter IDs, including
+// the ones grouped by four digits such as "0043 5687 0906"
+func TituloEleitorLocator() Locator {
+	return RegexpLocator(tituloEleitorRegexp, TituloEleitor())
+}
+
+// CNS generates a matcher for identifying Brazilian health card numbers
+// (Cartão Nacional de Saúde), both definitive and provisional
+func CNS() Matcher {
+	return Any(
+		matchCNS,
+	)
+}
+
+// CNSLocator generates a locator for Brazilian health card numbers, including
+// the ones grouped as "123 4567 8901 2345"
+func CNSLocator() Locator {
+	return RegexpLocator(cnsRegexp, CNS())
+}
+
+// PixKey generates a matcher for identifying Brazilian Pix keys: CPFs, CNPJs,
+// emails, "+55" phone numbers and random (EVP) keys. As EVP keys look like any
+// UUID v4, every UUID v4 matches; DescribePixKey gives them a "low" confidence
+// unless a keyword comes with them.
+func PixKey() Matcher {
+	return Any(
+		matchPixKey,
+	)
+}
+
+// PixKeyLocator generates a locator for Brazilian Pix keys. Phone keys are
+// found by their "+55" prefix and random keys by their UUID v4 format, as
+// PixKey does; CPFs, CNPJs and emails only when they come after or before a
+// keyword such as "pix" or "chave".
+func PixKeyLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(pixPhoneRegexp, nil),
+		RegexpLocator(uuid4Regexp, nil),
+		WithContext(AnyLocator(
+			RegexpLocator(cpfRegexp, CPF()),
+			CNPJLocator(),
+			RegexpLocator(emailRegexp, Email()),
+		), 40, pixKeywords...),
+	)
+}
+
+// DescribePixKey generates a describer reporting the type of a Pix key and the
+// confidence of the finding: "high" when it comes with a keyword such as "pix"
+// or "chave", "low" for random keys without one, which may be any UUID v4, and
+// "medium" for phone keys without one
+func DescribePixKey() Describer {
+	return func(s string, loc []int) map[string]string {
+		keyType := pixKeyType(s[loc[0]:loc[1]])
+		confidence := "medium"
+		switch {
+		case hasContext(s, loc, 40, pixKeywords):
+			confidence = "high"
+		case keyType == "evp":
+			confidence = "low"
+		}
+		return map[string]string{
+			"key_type":   keyType,
+			"confidence": confidence,
+		}
+	}
+}
+
+// Boleto generates a matcher for identifying Brazilian boleto typed lines
+// (linhas digitáveis), validating their field and general check digits
+func Boleto() Matcher {
+	return Any(
+		matchBoleto,
+	)
+}
+
+// BoletoLocator generates a locator for Brazilian boleto typed lines, which
+// are usually split by dots and spaces into several fields
+func BoletoLocator() Locator {
+	return RegexpLocator(boletoRegexp, Boleto())
+}
+
+// BrazilianPhone generates a matcher for identifying Brazilian phone numbers,
+// validating their area codes (DDDs) and the mobile ninth digit
+func BrazilianPhone() Matcher {
+	return Any(
+		matchBrazilianPhone,
+	)
+}
+
+// BrazilianPhoneLocator generates a locator for Brazilian phone numbers. As
+// unformatted numbers look like any other 10 or 11 digits, they are only
+// found after or before a keyword such as "telefone", unless they start with
+// "+55".
+func BrazilianPhoneLocator() Locator {
+	return AnyLocator(
+		RegexpLocator(brPhoneRegexp, And(BrazilianPhone(), Not(isNumeric))),
+		WithContext(RegexpLocator(brPhoneRegexp, BrazilianPhone()), 40, brPhoneKeywords...),
+	)
+}
+
+// DescribeAmbiguous generates a describer reporting the names of the other
+// documents, such as "CPF", whose matchers also accept the match
+func DescribeAmbiguous(documents map[string]Matcher) Describer {
+	return func(s string, loc []int) map[string]string {
+		var names []string
+		for name, m := range documents {
+			if m(s[loc[0]:loc[1]]) {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil
+		}
+		sort.Strings(names)
+		return map[string]string{"ambiguous_with": strings.Join(names, ",")}
+	}
+}
+
 // BrazilianPII generates a matcher for identifying Brazilian identification numbers
 func BrazilianPII() Matcher {
 	return Any(
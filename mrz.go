@@ -0,0 +1,249 @@
+package leakspok
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MRZ lines are separated by a line break, which JSON payloads escape as "\n"
+const (
+	mrzBreakPattern = `(?:\r?\n|(?:\\r)?\\n)`
+	mrzPattern      = `[A-Z0-9<]{44}` + mrzBreakPattern + `[A-Z0-9<]{44}|` +
+		`[A-Z0-9<]{36}` + mrzBreakPattern + `[A-Z0-9<]{36}|` +
+		`[A-Z0-9<]{30}` + mrzBreakPattern + `[A-Z0-9<]{30}` + mrzBreakPattern + `[A-Z0-9<]{30}`
+	mrzLinePattern        = `^(?:[A-Z0-9<]{44}|[A-Z0-9<]{36}|[A-Z0-9<]{30})\r?\n?$`
+	mrzFirstLinePattern   = `^[ACIPV][A-Z<][A-Z<]{3}`
+	passportNumberPattern = `[A-Z0-9]{6,9}`
+)
+
+var (
+	mrzRegexp            = regexp.MustCompile(mrzPattern)
+	mrzBreakRegexp       = regexp.MustCompile(mrzBreakPattern)
+	mrzLineRegexp        = regexp.MustCompile(mrzLinePattern)
+	mrzFirstLineRegexp   = regexp.MustCompile(mrzFirstLinePattern)
+	passportNumberRegexp = regexp.MustCompile(passportNumberPattern)
+)
+
+// passportKeywords are the words that usually come with a passport number
+var passportKeywords = []string{
+	"passport", "passport no", "passport number", "passaporte", "pasaporte", "passeport", "reisepass",
+	"passaporto",
+}
+
+var (
+	// TravelRuleSet provides a rule set of travel documents
+	TravelRuleSet = RuleSet{
+		"mrz":             DefaultMRZRule,
+		"passport_number": DefaultPassportNumberRule,
+	}
+
+	// DefaultMRZRule is a default rule for machine-readable zones of passports
+	// and identity cards
+	DefaultMRZRule = Rule{
+		Name:        "MRZ",
+		Description: "Machine-readable zone (MRZ) of a travel document",
+		Severity:    5,
+		Filter:      MRZ(),
+		Locate:      RegexpLocator(mrzRegexp, MRZ()),
+		Describe:    DescribeMRZ(),
+	}
+
+	// DefaultPassportNumberRule is a default rule for passport numbers
+	DefaultPassportNumberRule = Rule{
+		Name:        "passport_number",
+		Description: "Passport number",
+		Severity:    4,
+		Filter:      PassportNumber(),
+		Locate:      PassportNumberLocator(),
+	}
+)
+
+// mrzDocument holds the fields of a machine-readable zone
+type mrzDocument struct {
+	format         string
+	documentNumber string
+	nationality    string
+	birthDate      string
+}
+
+// MRZ generates a matcher for identifying the TD1, TD2 and TD3 machine-readable
+// zones of ICAO 9303 travel documents, validating their check digits
+func MRZ() Matcher {
+	return Any(
+		matchMRZ,
+	)
+}
+
+// DescribeMRZ generates a describer reporting the format, document number,
+// nationality and birth date of a machine-readable zone. Reports mask the
+// document number and the birth date unless they show matches.
+func DescribeMRZ() Describer {
+	return func(s string, loc []int) map[string]string {
+		doc, ok := parseMRZ(s[loc[0]:loc[1]])
+		if !ok {
+			return nil
+		}
+		return map[string]string{
+			"format":          doc.format,
+			"document_number": doc.documentNumber,
+			"nationality":     doc.nationality,
+			"birth_date":      doc.birthDate,
+		}
+	}
+}
+
+// PassportNumber generates a matcher for identifying passport numbers: 6 to 9
+// uppercase letters and digits, with at least six digits. Many codes look like
+// that, so it is meant for PassportNumberLocator.
+func PassportNumber() Matcher {
+	return Any(
+		matchPassportNumber,
+	)
+}
+
+// PassportNumberLocator generates a locator for passport numbers that come
+// after or before a keyword such as "passport"
+func PassportNumberLocator() Locator {
+	return WithContext(RegexpLocator(passportNumberRegexp, PassportNumber()), 40, passportKeywords...)
+}
+
+// matchMRZ returns a machine-readable zone match whose check digits are valid
+func matchMRZ(s string) bool {
+	_, ok := parseMRZ(s)
+	return ok
+}
+
+// parseMRZ returns the fields of a machine-readable zone with two lines of 44
+// characters (TD3, passports), two lines of 36 characters (TD2) or three lines
+// of 30 characters (TD1, identity cards), validating its check digits
+func parseMRZ(s string) (mrzDocument, bool) {
+	lines := mrzBreakRegexp.Split(s, -1)
+
+	var doc mrzDocument
+	var number, birth, expiry, composite string
+	switch {
+	case len(lines) == 2 && len(lines[0]) == 44 && len(lines[1]) == 44 && lines[0][0] == 'P':
+		doc.format = "TD3"
+		number, birth, expiry = lines[1][0:10], lines[1][13:20], lines[1][21:28]
+		doc.nationality = lines[1][10:13]
+		if !mrzCheck(lines[1][28:43]) {
+			return doc, false
+		}
+		composite = lines[1][0:10] + lines[1][13:20] + lines[1][21:43] + lines[1][43:]
+	case len(lines) == 2 && len(lines[0]) == 36 && len(lines[1]) == 36 &&
+		strings.IndexByte("ACIP", lines[0][0]) >= 0:
+		doc.format = "TD2"
+		number, birth, expiry = lines[1][0:10], lines[1][13:20], lines[1][21:28]
+		doc.nationality = lines[1][10:13]
+		composite = lines[1][0:10] + lines[1][13:20] + lines[1][21:35] + lines[1][35:]
+	case len(lines) == 3 && len(lines[0]) == 30 && len(lines[1]) == 30 && len(lines[2]) == 30 &&
+		strings.IndexByte("ACI", lines[0][0]) >= 0:
+		doc.format = "TD1"
+		number, birth, expiry = lines[0][5:15], lines[1][0:7], lines[1][8:15]
+		doc.nationality = lines[1][15:18]
+		composite = lines[0][5:30] + lines[1][0:7] + lines[1][8:15] + lines[1][18:29] + lines[1][29:]
+	default:
+		return doc, false
+	}
+
+	if !mrzCheck(number) || !mrzCheck(birth) || !mrzCheck(expiry) || !mrzCheck(composite) {
+		return doc, false
+	}
+
+	doc.documentNumber = strings.TrimRight(number[:9], "<")
+	doc.nationality = strings.TrimRight(doc.nationality, "<")
+	doc.birthDate = mrzDate(birth[:6])
+	return doc, doc.documentNumber != "" && doc.birthDate != ""
+}
+
+// mrzStreamBlock returns the block of an MRZ starting with line, so
+// AnonymizeStream holds its 2 lines of 44 or 36 characters, or 3 lines of 30
+// characters, together. The first line starts with the document type and the
+// issuing state, which the second one never does, so a stray line before an
+// MRZ doesn't hide it.
+func mrzStreamBlock(line string) (streamBlock, bool) {
+	if !mrzLineRegexp.MatchString(line) || !mrzFirstLineRegexp.MatchString(line) {
+		return streamBlock{}, false
+	}
+
+	width := len(strings.TrimRight(line, "\r\n"))
+	count := 2
+	if width == 30 {
+		count = 3
+	}
+
+	return streamBlock{
+		continues: func(lines []string, line string) bool {
+			if len(lines) == 1 && mrzFirstLineRegexp.MatchString(line) {
+				return false
+			}
+			return mrzLineRegexp.MatchString(line) && len(strings.TrimRight(line, "\r\n")) == width
+		},
+		complete: func(lines []string) bool {
+			return len(lines) == count
+		},
+	}, true
+}
+
+// mrzCheck reports whether the last character of field is the ICAO 9303 check
+// digit of the others. Digits keep their value, letters are valued from 10 to
+// 35 and fillers (<) 0, weighted by 7, 3 and 1. Empty optional fields may have
+// a filler as their check digit.
+func mrzCheck(field string) bool {
+	data, check := field[:len(field)-1], field[len(field)-1]
+	if check == '<' {
+		return strings.Trim(data, "<") == ""
+	}
+
+	weights := []int{7, 3, 1}
+	sum := 0
+	for i := 0; i < len(data); i++ {
+		var value int
+		switch c := data[i]; {
+		case c >= '0' && c <= '9':
+			value = int(c - '0')
+		case c >= 'A' && c <= 'Z':
+			value = int(c-'A') + 10
+		}
+		sum += value * weights[i%3]
+	}
+
+	return int(check-'0') == sum%10
+}
+
+// mrzDate returns a YYMMDD birth date in the YYYY-MM-DD format, assuming
+// years after the current one belong to the previous century, or "" when it
+// is not a date
+func mrzDate(s string) string {
+	century := "20"
+	if s[:2] > time.Now().Format("06") {
+		century = "19"
+	}
+	date, err := time.Parse("20060102", century+s)
+	if err != nil {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
+// matchPassportNumber returns a passport number match with 6 to 9 uppercase
+// letters and digits. Passports have at least six digits, as in the Brazilian
+// "FZ123456", the German "C01X00T47" or the Spanish "AAA123456", which leaves
+// out codes such as "ISO9001" or "RFC7231".
+func matchPassportNumber(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(passportNumberRegexp, s) {
+		return false
+	}
+
+	digits := 0
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			digits++
+		}
+	}
+	return digits >= 6
+}
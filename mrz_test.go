@@ -0,0 +1,119 @@
+package leakspok
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	td3Specimen = "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<10"
+	td2Specimen = "I<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<\nD231458907UTO7408122F1204159<<<<<<<6"
+	td1Specimen = "I<UTOD231458907<<<<<<<<<<<<<<<\n7408122F1204159UTO<<<<<<<<<<<6\nERIKSSON<<ANNA<MARIA<<<<<<<<<<"
+)
+
+func TestMRZCheck(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"L898902C36", true},
+		{"7408122", true},
+		{"D231458907", true},
+		{"ZE184226B<<<<<1", true},
+		{"<<<<<<<<<<<<<<<", true},
+		{"L898902C37", false},
+		{"ZE184226B<<<<<<", false},
+	}
+
+	for _, test := range tests {
+		if got := mrzCheck(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchMRZ(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{td3Specimen, true},
+		{td2Specimen, true},
+		{td1Specimen, true},
+		{strings.ReplaceAll(td3Specimen, "\n", "\r\n"), true},
+		{strings.ReplaceAll(td3Specimen, "\n", `\n`), true},
+		{strings.Replace(td3Specimen, "C36", "C37", 1), false},
+		{strings.Replace(td3Specimen, "<<<<<10", "<<<<<11", 1), false},
+		{strings.Replace(td1Specimen, "7408122", "7413122", 1), false},
+		{strings.Replace(td3Specimen, "P<", "V<", 1), false},
+	}
+
+	for _, test := range tests {
+		if got := matchMRZ(test.input); got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestDescribeMRZ(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect map[string]string
+	}{
+		{td3Specimen, map[string]string{
+			"format": "TD3", "document_number": "L898902C3", "nationality": "UTO", "birth_date": "1974-08-12",
+		}},
+		{td2Specimen, map[string]string{
+			"format": "TD2", "document_number": "D23145890", "nationality": "UTO", "birth_date": "1974-08-12",
+		}},
+		{td1Specimen, map[string]string{
+			"format": "TD1", "document_number": "D23145890", "nationality": "UTO", "birth_date": "1974-08-12",
+		}},
+	}
+
+	for _, test := range tests {
+		input := `{"mrz": "` + strings.ReplaceAll(test.input, "\n", `\n`) + `"}`
+		findings := NewStringTester(TravelRuleSet).FindAll(input)
+		if len(findings) != 1 {
+			t.Fatalf("For input %q expected a single finding but got %v", input, findings)
+		}
+		if got := findings[0].Metadata; !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("For input %q expected %v but got %v", input, test.expect, got)
+		}
+	}
+}
+
+func TestPassportNumberLocator(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect []string
+	}{
+		{"Passport No: L898902C3", []string{"L898902C3"}},
+		{"passaporte FZ123456 emitido", []string{"FZ123456"}},
+		{"passport holder ERIKSSON", nil},
+		{"booking L898902C3", nil},
+		{"Passport ISO9001 standard", nil},
+		{"passport office certified RFC7231", nil},
+		{"Reisepass C01X00T47", []string{"C01X00T47"}},
+		{"the number of my passport, issued in 2019, is FZ123456", []string{"FZ123456"}},
+	}
+
+	for _, test := range tests {
+		if got := locatedStrings(PassportNumberLocator(), test.input); !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMaskMetadata(t *testing.T) {
+	metadata := map[string]string{"document_number": "L898902C3", "birth_date": "1974-08-12", "nationality": "UTO"}
+	expected := map[string]string{"document_number": "L8*****C3", "birth_date": "19******12", "nationality": "UTO"}
+
+	if got := maskMetadata(metadata); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v but got %v", expected, got)
+	}
+	if metadata["document_number"] != "L898902C3" {
+		t.Errorf("Expected the metadata not to be modified but got %v", metadata)
+	}
+}
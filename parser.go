@@ -12,7 +12,24 @@ const (
 	phonePattern           = `(?:(?:\+?\d{1,3}[-.\s*]?)?(?:\(?\d{3}\)?[-.\s*]?)?\d{3}[-.\s*]?\d{4,6})|(?:(?:(?:\(\+?\d{2}\))|(?:\+?\d{2}))\s*\d{2}\s*\d{3}\s*\d{4})`
 	phonesWithExtsPattern  = `(?i)(?:(?:\+?1\s*(?:[.-]\s*)?)?(?:\(\s*(?:[2-9]1[02-9]|[2-9][02-8]1|[2-9][02-8][02-9])\s*\)|(?:[2-9]1[02-9]|[2-9][02-8]1|[2-9][02-8][02-9]))\s*(?:[.-]\s*)?)?(?:[2-9]1[02-9]|[2-9][02-9]1|[2-9][02-9]{2})\s*(?:[.-]\s*)?(?:[0-9]{4})(?:\s*(?:#|x\.?|ext\.?|extension)\s*(?:\d+)?)`
 	cpfPattern             = `(\d{3}\.\d{3}\.\d{3}-\d{2})|(\d{3}\.\d{3}\.\d{5})|(\d{9}-\d{2})|(\d{11})`
-	cnpjPattern            = `(\d{2}\.\d{3}\.\d{3}/\d{4}-\d{2}|(\d{14}))`
+	cnpjPattern            = `([\dA-Z]{2}\.[\dA-Z]{3}\.[\dA-Z]{3}/[\dA-Z]{4}-\d{2}|([\dA-Z]{12}\d{2}))`
+	cnpjLocatePattern      = `[\dA-Z]{2}\.?[\dA-Z]{3}\.?[\dA-Z]{3}/?[\dA-Z]{4}-?\d{2}`
+	rgPattern              = `(?i)(?:[a-z]{2}-?)?\d{1,2}\.?\d{3}\.?\d{3}(?:-?[\dx])?`
+	rgCheckedPattern       = `(?i)^(?:([a-z]{2})-?)?(\d{1,2}\.\d{3}\.\d{3}-[\dx])$`
+	cnhPattern             = `\d{11}`
+	pisPattern             = `^(?:\d{3}\.\d{5}\.\d{2}-\d|\d{11})$`
+	tituloEleitorPattern   = `\d{4} ?\d{4} ?\d{4}`
+	pixPhonePattern        = `\+55[1-9]{2}9?\d{8}`
+	brPhonePattern         = `(\+55 ?)?(?:\((0?\d{2})\)|(0?\d{2})) ?(9\d{4}|[2-5]\d{3})[- ]?(\d{4})`
+	cepPattern             = `\d{2}\.?\d{3}-?\d{3}`
+	cepFormattedPattern    = `^\d{2}\.?\d{3}-\d{3}$`
+	logradouroPattern      = `(?i)\b(?:rua|r\.|avenida|av\.?|travessa|tv\.|rodovia|rod\.|alameda|al\.|estrada|praça|largo)\s+[\p{L}\d][\p{L}\d .'-]{1,60}?,?\s*(?:(?:n[º°o]\.?|número|numero)\s*)?\d{1,5}\b`
+	complementPattern      = `(?i)(?:\bn[º°]\s*\d+|\bapto\.?\s*\d+|\bapartamento\s+\d+|\bbloco\s+\w+|\bbairro\b|\bcasa\s+\d+)`
+	placaPattern           = `[A-Z]{3}-?\d{4}|[A-Z]{3}\d[A-Z]\d{2}`
+	placaMercosulPattern   = `[A-Z]{3}\d[A-Z]\d{2}`
+	renavamPattern         = `\d{11}`
+	boletoPattern          = `8\d{10}[- ]?\d *\d{11}[- ]?\d *\d{11}[- ]?\d *\d{11}[- ]?\d|\d{5}\.?\d{5} *\d{5}\.?\d{6} *\d{5}\.?\d{6} *\d *\d{14}`
+	cnsPattern             = `[1-2789]\d{2} ?\d{4} ?\d{4} ?\d{4}`
 	linkPattern            = `(?:(?:https?:\/\/)?(?:[a-z0-9.\-]+|www|[a-z0-9.\-])[.](?:[^\s()<>]+|\((?:[^\s()<>]+|(?:\([^\s()<>]+\)))*\))+(?:\((?:[^\s()<>]+|(?:\([^\s()<>]+\)))*\)|[^\s!()\[\]{};:\'".,<>?]))`
 	emailPattern           = `(?i)([A-Za-z0-9!#$%&'*+\/=?^_{|.}~-]+@(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z0-9](?:[a-z0-9-]*[a-z0-9])?)`
 	ipv4Pattern            = `(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)`
@@ -26,9 +43,10 @@ const (
 	streetAddressPattern   = `(?i)\d{1,4} [\w ]{1,20}(?:street|st|avenue|ave|road|rd|highway|hwy|square|sq|trail|trl|drive|dr|court|ct|park|parkway|pkwy|circle|cir|boulevard|blvd)\W?`
 	zipCodePattern         = `\b\d{5}(?:[- ]\d{4})?\b`
 	poBoxPattern           = `(?i)P\.? ?O\.? Box \d+`
-	ssnPattern             = `\b\d{3}[- ]\d{2}[- ]\d{4}`
+	ssnPattern             = `\b\d{3}[- ]\d{2}[- ]\d{4}\b`
+	einPattern             = `\b\d{2}-\d{7}\b`
 	guidPattern            = `[0-9a-fA-F]{8}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{4}-?[a-fA-F0-9]{12}`
-	ibanPattern            = `(?i)[a-zA-Z]{2}[0-9]{2}[\t\f ]?[a-zA-Z0-9]{4}[\t\f ]?[0-9]{4}[\t\f ]?[0-9]{3}([a-zA-Z0-9][\t\f ]?[a-zA-Z0-9]{0,4}[\t\f ]?[a-zA-Z0-9]{0,4}[\t\f ]?[a-zA-Z0-9]{0,4}[\t\f ]?[a-zA-Z0-9]{0,3})?`
+	ibanPattern            = `(?i)[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,4})?`
 	vinPattern             = `[A-HJ-NPR-Z\d]{3}[A-HJ-NPR-Z\d]{5}[\dX][A-HJ-NPR-Z\d][A-HJ-NPR-Z\d][A-HJ-NPR-Z\d]{6}`
 	uuid3Pattern           = `(?i)[0-9a-f]{8}-[0-9a-f]{4}-3[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}`
 	uuid4Pattern           = `(?i)[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}`
@@ -51,11 +69,142 @@ const (
 	repeatingNumPattern    = `(?i)((0{5,})|(1{5,})|(2{5,})|(3{5,})|(4{5,})|(5{5,})|(6{5,})|(7{5,})|(8{5,})|(9{5,}))`
 )
 
+// cnpjKeywords are the words that usually come with a CNPJ
+var cnpjKeywords = []string{
+	"cnpj", "empresa", "razão social", "razao social", "fornecedor",
+}
+
+// rgKeywords are the words that usually come with an RG number
+var rgKeywords = []string{
+	"rg", "r.g", "identidade", "registro geral", "ssp", "órgão emissor", "orgao emissor",
+}
+
+// rgCheckDigits are the RG check digits published by each state, keyed by the
+// state prefix of the number. Numbers without a prefix are checked as São
+// Paulo ones. States missing here, such as MG, whose RGs have no check digit,
+// are left to the context of RGLocator.
+var rgCheckDigits = map[string]func(s string) bool{
+	"SP": rgCheckDigitSP,
+}
+
+// cnhKeywords are the words that usually come with a CNH number
+var cnhKeywords = []string{
+	"cnh", "habilitação", "habilitacao", "carteira de motorista", "carteira nacional de habilitação",
+	"carteira nacional de habilitacao", "registro nacional", "renach",
+}
+
+// pixKeywords are the words that usually come with a Pix key
+var pixKeywords = []string{
+	"pix", "chave", "chave aleatória", "chave aleatoria", "evp",
+}
+
+// brPhoneKeywords are the words that usually come with a phone number
+var brPhoneKeywords = []string{
+	"tel", "telefone", "fone", "cel", "celular", "whatsapp", "zap", "contato", "phone", "mobile",
+}
+
+// brAreaCodes are the valid Brazilian area codes (DDDs)
+var brAreaCodes = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true, "27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+// cepKeywords are the words that usually come with a CEP
+var cepKeywords = []string{
+	"cep", "código postal", "codigo postal", "endereço", "endereco",
+}
+
+// cepRange is the range of the first five digits of the CEPs of a state
+type cepRange struct {
+	from, to int
+	state    string
+}
+
+// cepRanges are the CEP ranges assigned to each Brazilian state
+var cepRanges = []cepRange{
+	{1000, 19999, "SP"}, {20000, 28999, "RJ"}, {29000, 29999, "ES"}, {30000, 39999, "MG"},
+	{40000, 48999, "BA"}, {49000, 49999, "SE"}, {50000, 56999, "PE"}, {57000, 57999, "AL"},
+	{58000, 58999, "PB"}, {59000, 59999, "RN"}, {60000, 63999, "CE"}, {64000, 64999, "PI"},
+	{65000, 65999, "MA"}, {66000, 68899, "PA"}, {68900, 68999, "AP"}, {69000, 69299, "AM"},
+	{69300, 69399, "RR"}, {69400, 69899, "AM"}, {69900, 69999, "AC"}, {70000, 72799, "DF"},
+	{72800, 72999, "GO"}, {73000, 73699, "DF"}, {73700, 76799, "GO"}, {76800, 76999, "RO"},
+	{77000, 77999, "TO"}, {78000, 78899, "MT"}, {78900, 78999, "RO"}, {79000, 79999, "MS"},
+	{80000, 87999, "PR"}, {88000, 89999, "SC"}, {90000, 99999, "RS"},
+}
+
+// placaKeywords are the words that usually come with a license plate
+var placaKeywords = []string{
+	"placa", "veículo", "veiculo", "plate", "license plate", "carro", "moto",
+}
+
+// renavamKeywords are the words that usually come with a RENAVAM number
+var renavamKeywords = []string{
+	"renavam", "veículo", "veiculo", "placa", "crlv", "detran", "vehicle",
+}
+
+// ibanLengths are the IBAN lengths registered for each country code
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// ssnExcluded are SSNs used in advertising or as examples, which were voided
+// or never issued to anyone
+var ssnExcluded = map[string]bool{
+	"078051120": true, "219099999": true, "457555462": true,
+}
+
+// einPrefixes are the EIN prefixes assigned by the IRS to its campuses and
+// to online applications
+var einPrefixes = map[string]bool{
+	"01": true, "02": true, "03": true, "04": true, "05": true, "06": true,
+	"10": true, "11": true, "12": true, "13": true, "14": true, "15": true, "16": true,
+	"20": true, "21": true, "22": true, "23": true, "24": true, "25": true, "26": true, "27": true,
+	"30": true, "31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "37": true, "38": true, "39": true,
+	"40": true, "41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true,
+	"50": true, "51": true, "52": true, "53": true, "54": true, "55": true, "56": true, "57": true, "58": true, "59": true,
+	"60": true, "61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true,
+	"71": true, "72": true, "73": true, "74": true, "75": true, "76": true, "77": true,
+	"80": true, "81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true,
+	"90": true, "91": true, "92": true, "93": true, "94": true, "95": true, "98": true, "99": true,
+}
+
 // Compiled regular expressions
 var (
 	phoneRegexp          = regexp.MustCompile(phonePattern)
 	cpfRegexp            = regexp.MustCompile(cpfPattern)
 	cnpjRegexp           = regexp.MustCompile(cnpjPattern)
+	cnpjLocateRegexp     = regexp.MustCompile(cnpjLocatePattern)
+	rgRegexp             = regexp.MustCompile(rgPattern)
+	rgCheckedRegexp      = regexp.MustCompile(rgCheckedPattern)
+	cnhRegexp            = regexp.MustCompile(cnhPattern)
+	pisRegexp            = regexp.MustCompile(pisPattern)
+	tituloEleitorRegexp  = regexp.MustCompile(tituloEleitorPattern)
+	cnsRegexp            = regexp.MustCompile(cnsPattern)
+	boletoRegexp         = regexp.MustCompile(boletoPattern)
+	brPhoneRegexp        = regexp.MustCompile(brPhonePattern)
+	cepRegexp            = regexp.MustCompile(cepPattern)
+	placaRegexp          = regexp.MustCompile(placaPattern)
+	placaMercosulRegexp  = regexp.MustCompile(placaMercosulPattern)
+	renavamRegexp        = regexp.MustCompile(renavamPattern)
+	cepFormattedRegexp   = regexp.MustCompile(cepFormattedPattern)
+	logradouroRegexp     = regexp.MustCompile(logradouroPattern)
+	complementRegexp     = regexp.MustCompile(complementPattern)
+	pixPhoneRegexp       = regexp.MustCompile(pixPhonePattern)
 	phonesWithExtsRegexp = regexp.MustCompile(phonesWithExtsPattern)
 	emailRegexp          = regexp.MustCompile(emailPattern)
 	ipv4Regexp           = regexp.MustCompile(ipv4Pattern)
@@ -65,6 +214,7 @@ var (
 	zipCodeRegexp        = regexp.MustCompile(zipCodePattern)
 	poBoxRegexp          = regexp.MustCompile(poBoxPattern)
 	ssnRegexp            = regexp.MustCompile(ssnPattern)
+	einRegexp            = regexp.MustCompile(einPattern)
 	guidRegexp           = regexp.MustCompile(guidPattern)
 	visaCreditCardRegexp = regexp.MustCompile(visaCreditCardPattern)
 	mcCreditCardRegexp   = regexp.MustCompile(mcCreditCardPattern)
@@ -188,16 +338,114 @@ func matchpoBox(s string) bool {
 	return poBoxRegexp.MatchString(s)
 }
 
+// matchssn returns a match for a US social security number in the
+// "123-45-6789" format within s. Areas 000, 666 and 900 to 999, group 00,
+// serial 0000 and the numbers used in advertising were never issued.
 func matchssn(s string) bool {
-	return ssnRegexp.MatchString(s)
+	for _, x := range ssnRegexp.FindAllString(s, -1) {
+		if x[3] != x[6] {
+			continue
+		}
+		area, group, serial := x[:3], x[4:6], x[7:]
+		if area == "000" || area == "666" || area[0] == '9' || group == "00" || serial == "0000" {
+			continue
+		}
+		if ssnExcluded[area+group+serial] {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// matchitin returns a match for a US individual taxpayer identification
+// number within s: an area from 900 to 999 and a group from 50 to 65, 70 to 88,
+// 90 to 92 or 94 to 99
+func matchitin(s string) bool {
+	for _, x := range ssnRegexp.FindAllString(s, -1) {
+		if x[3] != x[6] || x[0] != '9' {
+			continue
+		}
+		group, _ := strconv.Atoi(x[4:6])
+		if (group >= 50 && group <= 65) || (group >= 70 && group <= 88) ||
+			(group >= 90 && group <= 92) || group >= 94 {
+			return true
+		}
+	}
+	return false
+}
+
+// matchein returns a match for a US employer identification number in the
+// "12-3456789" format within s, with a prefix assigned by the IRS
+func matchein(s string) bool {
+	for _, x := range einRegexp.FindAllString(s, -1) {
+		if einPrefixes[x[:2]] {
+			return true
+		}
+	}
+	return false
 }
 
 func matchguid(s string) bool {
 	return guidRegexp.MatchString(s)
 }
 
+// matchiban returns an IBAN match, optionally grouped by four characters,
+// with the length registered for its country and a valid mod-97 checksum
 func matchiban(s string) bool {
-	return ibanRegexp.MatchString(s)
+	return ibanCountry(s) != ""
+}
+
+// ibanCountry returns the upper case country code of a valid IBAN, in either
+// case, or an empty string
+func ibanCountry(s string) string {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(ibanRegexp, s) {
+		return ""
+	}
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+
+	country := s[:2]
+	if ibanLengths[country] != len(s) {
+		return ""
+	}
+
+	// ISO 13616 moves the country code and the check digits to the end and
+	// reads letters as numbers from A = 10 to Z = 35: the result modulo 97 is 1
+	rest := 0
+	for _, c := range s[4:] + s[:4] {
+		if c >= 'A' {
+			rest = (rest*100 + int(c-'A') + 10) % 97
+		} else {
+			rest = (rest*10 + int(c-'0')) % 97
+		}
+	}
+	if rest != 1 {
+		return ""
+	}
+	return country
+}
+
+// ibanEnd returns the length of the prefix of the IBAN candidate s holding as
+// many characters as registered for its country, so a word grouped after the
+// IBAN, as in "BE68 5390 0754 7034 EUR", is left out. It returns len(s) for
+// unknown countries and shorter candidates.
+func ibanEnd(s string) int {
+	n, ok := ibanLengths[strings.ToUpper(s[:2])]
+	if !ok {
+		return len(s)
+	}
+	for i := range s {
+		if n == 0 {
+			return i
+		}
+		if s[i] != ' ' {
+			n--
+		}
+	}
+	return len(s)
 }
 
 func matchvin(s string) bool {
@@ -271,13 +519,15 @@ func matchCPF(s string) bool {
 	return checkDigit1 == result1 && checkDigit2 == result2
 }
 
-// matchCNPJ returns a Brazilian CNPJ match
+// matchCNPJ returns a Brazilian CNPJ match, either numeric or alphanumeric.
+// Alphanumeric CNPJs may have uppercase letters within their first 12
+// characters, valued by their ASCII code minus 48 on the check digits.
 func matchCNPJ(s string) bool {
 
 	replacer := strings.NewReplacer(`"`, "", `,`, "", `]`, "", `}`, "", `.`, "", `-`, "", `\`, "", `/`, "", `!`, "", `?`, "")
 	s = replacer.Replace(s)
 
-	// A valid CNPJ must have 14 digits without punctuations
+	// A valid CNPJ must have 14 characters without punctuations
 	if len(s) != 14 {
 		return false
 	}
@@ -285,12 +535,12 @@ func matchCNPJ(s string) bool {
 		return false
 	}
 
-	// Remove non-numeric characters
+	// Remove non-alphanumeric characters
 	s = strings.Join(strings.FieldsFunc(s, func(r rune) bool {
-		return r < '0' || r > '9'
+		return (r < '0' || r > '9') && (r < 'A' || r > 'Z')
 	}), "")
 
-	// A valid CNPJ must have 14 digits
+	// A valid CNPJ must have 14 characters
 	if len(s) != 14 {
 		return false
 	}
@@ -302,7 +552,7 @@ func matchCNPJ(s string) bool {
 	)
 
 	firstPart := s[:12]
-	sum1 := sumDigit(firstPart, cnpjFirstDigitTable)
+	sum1 := sumAlnum(firstPart, cnpjFirstDigitTable)
 	rest1 := sum1 % 11
 	d1 := 0
 
@@ -311,7 +561,7 @@ func matchCNPJ(s string) bool {
 	}
 
 	secondPart := fmt.Sprintf("%s%d", firstPart, d1)
-	sum2 := sumDigit(secondPart, cnpjSecondDigitTable)
+	sum2 := sumAlnum(secondPart, cnpjSecondDigitTable)
 	rest2 := sum2 % 11
 	d2 := 0
 
@@ -322,3 +572,411 @@ func matchCNPJ(s string) bool {
 	finalPart := fmt.Sprintf("%s%d", secondPart, d2)
 	return finalPart == s
 }
+
+// matchBareAlphanumericCNPJ returns whether s is an alphanumeric CNPJ without
+// punctuation, which may as well be any product code or identifier
+func matchBareAlphanumericCNPJ(s string) bool {
+	return len(s) == 14 && strings.ContainsAny(s, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+}
+
+// matchRG returns a Brazilian RG match in the "12.345.678-9" format, optionally
+// prefixed by its state as in "SP-12.345.678-9", validating the check digit
+// published by the state in rgCheckDigits
+func matchRG(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	groups := rgCheckedRegexp.FindStringSubmatch(s)
+	if groups == nil {
+		return false
+	}
+
+	state := strings.ToUpper(groups[1])
+	if state == "" {
+		state = "SP"
+	}
+	check, ok := rgCheckDigits[state]
+	if !ok {
+		return false
+	}
+
+	s = strings.ToUpper(strings.NewReplacer(".", "", "-", "").Replace(groups[2]))
+	// Older numbers have seven digits before the check digit
+	if len(s) == 8 {
+		s = "0" + s
+	}
+
+	if allSameDigit(s[:8]) {
+		return false
+	}
+
+	return check(s)
+}
+
+// rgCheckDigitSP validates the check digit of a São Paulo RG with nine
+// characters. The eight digits are weighted from 2 to 9 and the check digit,
+// where X stands for 10, by 100: the weighted sum must be a multiple of 11.
+func rgCheckDigitSP(s string) bool {
+	sum := sumDigit(s[:8], []int{2, 3, 4, 5, 6, 7, 8, 9})
+	checkDigit := 10
+	if s[8] != 'X' {
+		checkDigit = int(s[8] - '0')
+	}
+
+	return (sum+checkDigit*100)%11 == 0
+}
+
+// matchCNH returns a Brazilian CNH (driver's license) match with 11 digits,
+// validating both check digits. The first one is the weighted sum of the nine
+// digits modulo 11; when it overflows to 10 it becomes 0 and the second one,
+// computed with ascending weights, is lowered by 2.
+func matchCNH(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if len(s) != 11 || !cnhRegexp.MatchString(s) {
+		return false
+	}
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	first := sumDigit(s[:9], []int{9, 8, 7, 6, 5, 4, 3, 2, 1}) % 11
+	discount := 0
+	if first >= 10 {
+		first, discount = 0, 2
+	}
+
+	second := sumDigit(s[:9], []int{1, 2, 3, 4, 5, 6, 7, 8, 9})%11 - discount
+	if second < 0 {
+		second += 11
+	}
+	if second >= 10 {
+		second = 0
+	}
+
+	return int(s[9]-'0') == first && int(s[10]-'0') == second
+}
+
+// matchPIS returns a Brazilian PIS/PASEP/NIT/NIS match in the "123.45678.90-1"
+// format, or unformatted, validating its check digit
+func matchPIS(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !pisRegexp.MatchString(s) {
+		return false
+	}
+
+	s = strings.NewReplacer(".", "", "-", "").Replace(s)
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	checkDigit := 11 - sumDigit(s[:10], []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2})%11
+	if checkDigit >= 10 {
+		checkDigit = 0
+	}
+
+	return int(s[10]-'0') == checkDigit
+}
+
+// matchTituloEleitor returns a Brazilian voter ID (título de eleitor) match
+// with 12 digits, optionally grouped by four, validating its state code and
+// both check digits
+func matchTituloEleitor(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(tituloEleitorRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	// The ninth and tenth digits are the code of the state, from 01 to 28
+	state, _ := strconv.Atoi(s[8:10])
+	if state < 1 || state > 28 {
+		return false
+	}
+
+	first := tituloEleitorDigit(sumDigit(s[:8], []int{2, 3, 4, 5, 6, 7, 8, 9}), state)
+	second := tituloEleitorDigit(sumDigit(s[8:10]+strconv.Itoa(first), []int{7, 8, 9}), state)
+
+	return int(s[10]-'0') == first && int(s[11]-'0') == second
+}
+
+// tituloEleitorDigit returns the check digit of a weighted sum. São Paulo (01)
+// and Minas Gerais (02) use 1 instead of 0 when the sum is a multiple of 11.
+func tituloEleitorDigit(sum, state int) int {
+	rest := sum % 11
+	switch {
+	case rest == 10:
+		return 0
+	case rest == 0 && (state == 1 || state == 2):
+		return 1
+	default:
+		return rest
+	}
+}
+
+// matchCNS returns a Brazilian health card (Cartão Nacional de Saúde) match
+// with 15 digits, optionally grouped as "123 4567 8901 2345". Definitive
+// numbers start with 1 or 2 and provisional ones with 7, 8 or 9; both must have
+// a sum of their digits, weighted from 15 down to 1, multiple of 11.
+func matchCNS(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(cnsRegexp, s) {
+		return false
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	sum := sumDigit(s, []int{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1})
+	return sum%11 == 0
+}
+
+// pixKeyType returns the type of a Brazilian Pix key: "cpf", "cnpj", "email",
+// "phone" for "+55" phone numbers or "evp" for random UUID v4 keys. It returns
+// an empty string when s isn't a Pix key.
+func pixKeyType(s string) string {
+
+	s = stripPunctuation.Replace(s)
+
+	switch {
+	case fullMatch(uuid4Regexp, s):
+		return "evp"
+	case fullMatch(pixPhoneRegexp, s):
+		return "phone"
+	case matchCPF(s):
+		return "cpf"
+	case matchCNPJ(s):
+		return "cnpj"
+	case fullMatch(emailRegexp, s) && matchemail(s):
+		return "email"
+	default:
+		return ""
+	}
+}
+
+// matchPixKey returns a Brazilian Pix key match of any type
+func matchPixKey(s string) bool {
+	return pixKeyType(s) != ""
+}
+
+// matchBoleto returns a Brazilian boleto typed line (linha digitável) match,
+// either a bank boleto with 47 digits or a utility (convênio) one with 48
+// digits starting with 8, optionally with its dots, dashes and spaces
+func matchBoleto(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(boletoRegexp, s) {
+		return false
+	}
+	s = strings.NewReplacer(".", "", "-", "", " ", "").Replace(s)
+
+	switch len(s) {
+	case 47:
+		return matchBankBoleto(s)
+	case 48:
+		return matchConvenioBoleto(s)
+	default:
+		return false
+	}
+}
+
+// matchBankBoleto validates the mod-10 check digits of the first three fields
+// of a bank boleto typed line and the mod-11 general check digit of its barcode
+func matchBankBoleto(s string) bool {
+	fields := []string{s[0:10], s[10:21], s[21:32]}
+	for _, field := range fields {
+		if boletoMod10(field[:len(field)-1]) != int(field[len(field)-1]-'0') {
+			return false
+		}
+	}
+
+	// The barcode moves the due date factor and the value before the free field
+	barcode := s[0:4] + s[33:47] + s[4:9] + s[10:20] + s[21:31]
+	checkDigit := 11 - boletoWeightedSum(barcode)%11
+	if checkDigit == 0 || checkDigit >= 10 {
+		checkDigit = 1
+	}
+
+	return int(s[32]-'0') == checkDigit
+}
+
+// matchConvenioBoleto validates the check digits of the four blocks of a
+// utility boleto typed line and the general check digit of its barcode. The
+// third digit selects mod-10 (6 or 7) or mod-11 (8 or 9) check digits.
+func matchConvenioBoleto(s string) bool {
+	var checkDigit func(string) int
+	switch s[2] {
+	case '6', '7':
+		checkDigit = boletoMod10
+	case '8', '9':
+		checkDigit = func(digits string) int {
+			rest := boletoWeightedSum(digits) % 11
+			if rest <= 1 {
+				return 0
+			}
+			return 11 - rest
+		}
+	default:
+		return false
+	}
+
+	var barcode string
+	for i := 0; i < 48; i += 12 {
+		block := s[i : i+11]
+		if checkDigit(block) != int(s[i+11]-'0') {
+			return false
+		}
+		barcode += block
+	}
+
+	return checkDigit(barcode[:3]+barcode[4:]) == int(barcode[3]-'0')
+}
+
+// boletoMod10 returns the mod-10 check digit of digits, weighted 2 and 1 from
+// the right, adding up the digits of each product
+func boletoMod10(digits string) int {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * weight
+		sum += product/10 + product%10
+		weight = 3 - weight
+	}
+	return (10 - sum%10) % 10
+}
+
+// boletoWeightedSum returns the sum of digits weighted from 2 to 9 from the
+// right, starting again from 2 after 9, used by the mod-11 check digits
+func boletoWeightedSum(digits string) int {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	return sum
+}
+
+// matchBrazilianPhone returns a Brazilian phone number match, such as
+// "+55 11 98765-4321", "(11) 3265-4321", "011 98765-4321" or "11987654321",
+// validating its area code. Mobile numbers have nine digits starting with 9
+// and landlines eight digits starting with 2 to 5.
+func matchBrazilianPhone(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(brPhoneRegexp, s) {
+		return false
+	}
+
+	groups := brPhoneRegexp.FindStringSubmatch(s)
+	areaCode := groups[2] + groups[3]
+	// The trunk prefix 0 is only dialed within Brazil
+	if strings.HasPrefix(areaCode, "0") {
+		if groups[1] != "" {
+			return false
+		}
+		areaCode = areaCode[1:]
+	}
+
+	return len(areaCode) == 2 && brAreaCodes[areaCode]
+}
+
+// cepState returns the Brazilian state of a CEP such as "01310-100",
+// "01.310-100" or "01310100", or an empty string when s isn't a valid CEP
+func cepState(s string) string {
+
+	s = stripPunctuation.Replace(s)
+
+	if !fullMatch(cepRegexp, s) {
+		return ""
+	}
+	s = strings.NewReplacer(".", "", "-", "").Replace(s)
+
+	prefix, _ := strconv.Atoi(s[:5])
+	for _, r := range cepRanges {
+		if prefix >= r.from && prefix <= r.to {
+			return r.state
+		}
+	}
+	return ""
+}
+
+// matchCEP returns a Brazilian postal code (CEP) match whose first five digits
+// are within the range of a state
+func matchCEP(s string) bool {
+	return cepState(s) != ""
+}
+
+// matchFormattedCEP returns a CEP match with its dash, such as "01310-100"
+func matchFormattedCEP(s string) bool {
+	return cepFormattedRegexp.MatchString(s) && matchCEP(s)
+}
+
+// containsCEP reports whether s contains a valid CEP that isn't part of a
+// longer number
+func containsCEP(s string) bool {
+	for _, loc := range cepRegexp.FindAllStringIndex(s, -1) {
+		if isStandalone(s, loc[0], loc[1]) && matchCEP(s[loc[0]:loc[1]]) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchlogradouro(s string) bool {
+	return logradouroRegexp.MatchString(s)
+}
+
+func matchcomplement(s string) bool {
+	return complementRegexp.MatchString(s)
+}
+
+// matchPlaca returns a Brazilian license plate match, either in the old
+// "ABC-1234" format or in the Mercosul "ABC1D23" one
+func matchPlaca(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	return fullMatch(placaRegexp, s)
+}
+
+// matchPlacaMercosul returns a Brazilian license plate match in the Mercosul
+// "ABC1D23" format
+func matchPlacaMercosul(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	return fullMatch(placaMercosulRegexp, s)
+}
+
+// matchRENAVAM returns a Brazilian vehicle registration (RENAVAM) match with 11
+// digits. The first ten digits are weighted by 3, 2, 9, 8, 7, 6, 5, 4, 3 and 2,
+// and the check digit is 11 minus their sum modulo 11, or 0 when it overflows.
+func matchRENAVAM(s string) bool {
+
+	s = stripPunctuation.Replace(s)
+
+	if len(s) != 11 || !renavamRegexp.MatchString(s) {
+		return false
+	}
+
+	if allSameDigit(s) {
+		return false
+	}
+
+	checkDigit := 11 - sumDigit(s[:10], []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2})%11
+	if checkDigit >= 10 {
+		checkDigit = 0
+	}
+
+	return int(s[10]-'0') == checkDigit
+}
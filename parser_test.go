@@ -62,6 +62,12 @@ func TestMatchCNPJ(t *testing.T) {
 		{`14380200/000121"]}`, true},
 		{`14380200/000122"`, false},
 		{"11.444.777/0001-60", false},
+		{"12.ABC.345/01DE-35", true},
+		{"12ABC34501DE35", true},
+		{`"12.ABC.345/01DE-35",`, true},
+		{"12.ABC.345/01DE-36", false},
+		{"12.abc.345/01de-35", false},
+		{"12.ABC.345/01DE-3X", false},
 		{"", false},
 	}
 
@@ -120,3 +126,453 @@ func TestMatchEmail(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchRG(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"12.345.678-2", true},
+		{"24.678.131-2", true},
+		{"12.345.671-X", true},
+		{"12.345.671-x", true},
+		{"1.234.567-2", true},
+		{`"12.345.678-2",`, true},
+		{"SP-12.345.678-2", true},
+		{"sp12.345.678-2", true},
+		{"12.345.678-3", false},
+		{"SP-12.345.678-3", false},
+		{"RJ-12.345.678-2", false},
+		{"MG-12.345.678", false},
+		{"12.345.671-0", false},
+		{"123456782", false},
+		{"11.111.111-0", false},
+		{"111.444.777-35", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		got := matchRG(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchCNH(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"02650306461", true},
+		{"10000009714", true},
+		{"10000109425", true},
+		{"10000001333", true},
+		{"10000002800", true},
+		{"10000028109", true},
+		{"10000002809", false},
+		{`"02650306461",`, true},
+		{"02650306462", false},
+		{"02650306451", false},
+		{"11111111111", false},
+		{"0265030646", false},
+		{"026.503.064-61", false},
+	}
+
+	for _, test := range tests {
+		got := matchCNH(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchPIS(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"170.33259.50-4", true},
+		{"17033259504", true},
+		{`"170.33259.50-4",`, true},
+		{"120.12345.67-2", true},
+		{"170.33259.50-3", false},
+		{"17033259503", false},
+		{"170.3325.950-4", false},
+		{"1703325950", false},
+		{"000.00000.00-0", false},
+	}
+
+	for _, test := range tests {
+		got := matchPIS(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchTituloEleitor(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"004356870906", true},
+		{"0043 5687 0906", true},
+		{`"004356870906",`, true},
+		{"100000010116", true},
+		{"100000010302", true},
+		{"123456780299", true},
+		{"123456782895", true},
+		{"004356870907", false},
+		{"100000010106", false},
+		{"123456782995", false},
+		{"123456780095", false},
+		{"00435687090", false},
+		{"0043-5687-0906", false},
+	}
+
+	for _, test := range tests {
+		got := matchTituloEleitor(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchCNS(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"123456789010000", true},
+		{"212345678901005", true},
+		{"700000000000005", true},
+		{"702002887429583", true},
+		{"812345678901003", true},
+		{"912345678901018", true},
+		{"702 0028 8742 9583", true},
+		{`"702002887429583",`, true},
+		{"702002887429584", false},
+		{"312345678901001", false},
+		{"612345678901000", false},
+		{"70200288742958", false},
+		{"702-0028-8742-9583", false},
+	}
+
+	for _, test := range tests {
+		got := matchCNS(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestPixKeyType(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"123e4567-e89b-42d3-a456-556642440000", "evp"},
+		{"+5511987654321", "phone"},
+		{"+551132654321", "phone"},
+		{"111.444.777-35", "cpf"},
+		{"11144477735", "cpf"},
+		{"11.444.777/0001-61", "cnpj"},
+		{"joao.silva@gmail.com", "email"},
+		{`"123e4567-e89b-42d3-a456-556642440000",`, "evp"},
+		{"123e4567-e89b-12d3-a456-556642440000", ""},
+		{"+14155552671", ""},
+		{"+5501987654321", ""},
+		{"11987654321", ""},
+		{"111.444.777-34", ""},
+		{"pix", ""},
+	}
+
+	for _, test := range tests {
+		got := pixKeyType(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchBoleto(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"23791.23017 60000.000053 25000.456704 2 84410000026000", true},
+		{"23791230176000000005325000456704284410000026000", true},
+		{"00190.00009 02000.000006 00000.000174 9 10000000010000", true},
+		{"83670000000-0 11331201380-9 00812884627-9 10801361815-3", true},
+		{"858600000004113312013807008128846270108013618158", true},
+		{"23791.23017 60000.000053 25000.456704 3 84410000026000", false},
+		{"23791.23018 60000.000053 25000.456704 2 84410000026000", false},
+		{"23791.23017 60000.000053 25000.456704 2 84410000026001", false},
+		{"83670000000-0 11331201380-9 00812884627-9 10801361815-4", false},
+		{"83670000000-1 11331201380-9 00812884627-9 10801361815-3", false},
+		{"83170000000-0 11331201380-9 00812884627-9 10801361815-3", false},
+		{"23791.23017 60000.000053", false},
+	}
+
+	for _, test := range tests {
+		got := matchBoleto(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchBrazilianPhone(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"+55 11 98765-4321", true},
+		{"+55 (11) 98765-4321", true},
+		{"+5511987654321", true},
+		{"(11) 98765-4321", true},
+		{"(11) 3265-4321", true},
+		{"(011) 98765-4321", true},
+		{"011 98765-4321", true},
+		{"21 2345-6789", true},
+		{"11987654321", true},
+		{"1132654321", true},
+		{`"(11) 98765-4321",`, true},
+		{"(10) 98765-4321", false},
+		{"(20) 3265-4321", false},
+		{"+55 011 98765-4321", false},
+		{"(11) 88765-4321", false},
+		{"(11) 6265-4321", false},
+		{"11 9876-54321", false},
+		{"+1 415 555 2671", false},
+	}
+
+	for _, test := range tests {
+		got := matchBrazilianPhone(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestSSN(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"536-22-1234", true},
+		{"536 22 1234", true},
+		{"SSN: 665-01-0001.", true},
+		{"000-12-3456", false},
+		{"666-12-3456", false},
+		{"900-12-3456", false},
+		{"999-12-3456", false},
+		{"536-00-1234", false},
+		{"536-22-0000", false},
+		{"078-05-1120", false},
+		{"219-09-9999", false},
+		{"536-22 1234", false},
+		{"2021-03-1234", false},
+		{"536-22-12345", false},
+	}
+
+	for _, test := range tests {
+		got := SSN()(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestITIN(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"912-70-1234", true},
+		{"999-88-1234", true},
+		{"900-50-1234", true},
+		{"912 94 1234", true},
+		{"912-69-1234", false},
+		{"912-93-1234", false},
+		{"812-70-1234", false},
+		{"912-70 1234", false},
+	}
+
+	for _, test := range tests {
+		got := ITIN()(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestEIN(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"12-3456789", true},
+		{"EIN 98-7654321", true},
+		{"07-3456789", false},
+		{"89-3456789", false},
+		{"96-3456789", false},
+		{"2023-3456789", false},
+		{"12-34567890", false},
+	}
+
+	for _, test := range tests {
+		got := EIN()(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestIBANCountry(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"DE89370400440532013000", "DE"},
+		{"DE89 3704 0044 0532 0130 00", "DE"},
+		{"GB82 WEST 1234 5698 7654 32", "GB"},
+		{"FR14 2004 1010 0505 0001 3M02 606", "FR"},
+		{"NL91ABNA0417164300", "NL"},
+		{"NO93 8601 1117 947", "NO"},
+		{"MT84 MALT 0110 0001 2345 MTLC AST0 01S", "MT"},
+		{`"NL91ABNA0417164300",`, "NL"},
+		{"DE89 3704 0044 0532 0130 01", ""},
+		{"GB82 WEST 1234 5698 7654 3", ""},
+		{"XX89370400440532013000", ""},
+		{"OR12345678901234", ""},
+		{"de89370400440532013000", "DE"},
+		{"be68 5390 0754 7034", "BE"},
+	}
+
+	for _, test := range tests {
+		got := ibanCountry(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchPlaca(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"ABC-1234", true},
+		{"ABC1234", true},
+		{"ABC1D23", true},
+		{`"BRA2E19",`, true},
+		{"abc-1234", false},
+		{"AB-1234", false},
+		{"ABC-123", false},
+		{"ABC1DD3", false},
+		{"ABCD1234", false},
+	}
+
+	for _, test := range tests {
+		got := matchPlaca(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchPlacaMercosul(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"ABC1D23", true},
+		{`"BRA2E19",`, true},
+		{"ABC-1234", false},
+		{"ABC1234", false},
+		{"ISO-9001", false},
+	}
+
+	for _, test := range tests {
+		got := matchPlacaMercosul(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestMatchRENAVAM(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"00639884962", true},
+		{"12345678900", true},
+		{"98765432103", true},
+		{"00000123455", true},
+		{`"00639884962",`, true},
+		{"00639884963", false},
+		{"0639884962", false},
+		{"00000000000", false},
+		{"0063988496X", false},
+	}
+
+	for _, test := range tests {
+		got := matchRENAVAM(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestCEPState(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"01310-100", "SP"},
+		{"01.310-100", "SP"},
+		{"01310100", "SP"},
+		{"20040-020", "RJ"},
+		{"30130-010", "MG"},
+		{"69301-000", "RR"},
+		{"70040-010", "DF"},
+		{"90010-150", "RS"},
+		{`"01310-100",`, "SP"},
+		{"00999-000", ""},
+		{"0131-0100", ""},
+		{"01310-10", ""},
+	}
+
+	for _, test := range tests {
+		got := cepState(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
+
+func TestBrazilianAddress(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect bool
+	}{
+		{"Rua Augusta, 1500 - Consolação, São Paulo - SP, 01310-100", true},
+		{"Av. Paulista, nº 1578, apto 12", true},
+		{"Avenida Rio Branco 156, bairro Centro", true},
+		{"Travessa do Comércio, 25, casa 2", true},
+		{"Rodovia BR-116, 1200, CEP 85000-000", true},
+		{"Rua Augusta, 1500", false},
+		{"Av. Paulista, 1578 - 00999-000", false},
+		{"entrega no bairro amanhã", false},
+	}
+
+	for _, test := range tests {
+		got := BrazilianAddress()(test.input)
+		if got != test.expect {
+			t.Errorf("For input %q expected %v but got %v", test.input, test.expect, got)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+package leakspok
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Reporter writes findings in a specific output format
+type Reporter interface {
+	// Report writes, or buffers, a single finding
+	Report(f Finding) error
+	// Close writes anything still pending, such as buffered findings or summaries
+	Close() error
+}
+
+// ReportOptions defines the options shared by all reporters
+type ReportOptions struct {
+	// ShowMatches writes findings as they were found instead of masking them
+	ShowMatches bool
+	// Color enables ANSI colors on the table reporter
+	Color bool
+}
+
+// reporterFactories registers the reporters by format name
+var reporterFactories = map[string]func(io.Writer, ReportOptions) Reporter{
+	"text":  NewTextReporter,
+	"jsonl": NewJSONLinesReporter,
+	"csv":   NewCSVReporter,
+	"table": NewTableReporter,
+	"sarif": NewSARIFReporter,
+}
+
+// ReportFormats returns the names of all report formats, sorted
+func ReportFormats() []string {
+	formats := make([]string, 0, len(reporterFactories))
+	for name := range reporterFactories {
+		formats = append(formats, name)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// NewReporter returns the reporter for the named format
+func NewReporter(format string, w io.Writer, opts ReportOptions) (Reporter, error) {
+	factory, ok := reporterFactories[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown report format %q", format)
+	}
+	return factory(w, opts), nil
+}
+
+// Summary counts findings per rule and per severity
+type Summary struct {
+	Total      int            `json:"total"`
+	ByRule     map[string]int `json:"by_rule"`
+	BySeverity map[int]int    `json:"by_severity"`
+}
+
+// NewSummary returns an empty Summary
+func NewSummary() *Summary {
+	return &Summary{
+		ByRule:     map[string]int{},
+		BySeverity: map[int]int{},
+	}
+}
+
+// Add counts a finding
+func (s *Summary) Add(f Finding) {
+	s.Total++
+	s.ByRule[f.Rule.Name]++
+	s.BySeverity[f.Rule.Severity]++
+}
+
+// findingRecord is the flat representation of a finding used by reporters
+type findingRecord struct {
+	File        string            `json:"file,omitempty"`
+	Line        int               `json:"line"`
+	Column      int               `json:"column"`
+	Rule        string            `json:"rule"`
+	Description string            `json:"description,omitempty"`
+	Severity    int               `json:"severity"`
+	Match       string            `json:"match"`
+	Commit      string            `json:"commit,omitempty"`
+	Author      string            `json:"author,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+func newFindingRecord(f Finding, opts ReportOptions) findingRecord {
+	match, metadata := f.Match, f.Metadata
+	if !opts.ShowMatches {
+		match, metadata = maskSnippet(match), maskMetadata(metadata)
+	}
+	return findingRecord{
+		File:        f.File,
+		Line:        f.Line,
+		Column:      f.Column,
+		Rule:        f.Rule.Name,
+		Description: f.Rule.Description,
+		Severity:    f.Rule.Severity,
+		Match:       match,
+		Commit:      f.Commit,
+		Author:      f.Author,
+		Metadata:    metadata,
+	}
+}
+
+// metadata returns the metadata as "key=value" pairs sorted by key
+func (rec findingRecord) metadata() []string {
+	pairs := make([]string, 0, len(rec.Metadata))
+	for key, value := range rec.Metadata {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// location returns "file:line:column", prefixed by the commit when there is one
+func (rec findingRecord) location() string {
+	location := fmt.Sprintf("%s:%d:%d", rec.File, rec.Line, rec.Column)
+	if rec.Commit != "" {
+		location = shortCommit(rec.Commit) + ":" + location
+	}
+	return location
+}
+
+// shortCommit abbreviates a commit hash the way git does by default
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
+
+// textReporter writes one "file:line:column: rule" line per finding
+type textReporter struct {
+	w    io.Writer
+	opts ReportOptions
+}
+
+// NewTextReporter returns a reporter writing one line per finding in the
+// "file:line:column: rule (severity N): description" format used by compilers.
+// Findings within a git history are prefixed by their abbreviated commit.
+func NewTextReporter(w io.Writer, opts ReportOptions) Reporter {
+	return &textReporter{w: w, opts: opts}
+}
+
+func (r *textReporter) Report(f Finding) error {
+	rec := newFindingRecord(f, r.opts)
+	line := fmt.Sprintf("%s: %s (severity %d): %s [%s]",
+		rec.location(), rec.Rule, rec.Severity, ruleDescription(f.Rule), rec.Match)
+	if pairs := rec.metadata(); len(pairs) > 0 {
+		line += " " + strings.Join(pairs, " ")
+	}
+	_, err := fmt.Fprintln(r.w, line)
+	return err
+}
+
+func (r *textReporter) Close() error {
+	return nil
+}
+
+// jsonLinesReporter writes one JSON object per finding
+type jsonLinesReporter struct {
+	encoder *json.Encoder
+	opts    ReportOptions
+}
+
+// NewJSONLinesReporter returns a reporter writing one JSON object per line
+func NewJSONLinesReporter(w io.Writer, opts ReportOptions) Reporter {
+	return &jsonLinesReporter{encoder: json.NewEncoder(w), opts: opts}
+}
+
+func (r *jsonLinesReporter) Report(f Finding) error {
+	return r.encoder.Encode(newFindingRecord(f, r.opts))
+}
+
+func (r *jsonLinesReporter) Close() error {
+	return nil
+}
+
+// csvReporter writes findings as CSV rows preceded by a header
+type csvReporter struct {
+	writer      *csv.Writer
+	opts        ReportOptions
+	wroteHeader bool
+}
+
+// NewCSVReporter returns a reporter writing findings as CSV rows
+func NewCSVReporter(w io.Writer, opts ReportOptions) Reporter {
+	return &csvReporter{writer: csv.NewWriter(w), opts: opts}
+}
+
+func (r *csvReporter) Report(f Finding) error {
+	if !r.wroteHeader {
+		r.wroteHeader = true
+		if err := r.writer.Write([]string{"file", "line", "column", "rule", "description", "severity", "match", "commit", "author", "metadata"}); err != nil {
+			return err
+		}
+	}
+
+	rec := newFindingRecord(f, r.opts)
+	return r.writer.Write([]string{
+		rec.File,
+		strconv.Itoa(rec.Line),
+		strconv.Itoa(rec.Column),
+		rec.Rule,
+		rec.Description,
+		strconv.Itoa(rec.Severity),
+		rec.Match,
+		rec.Commit,
+		rec.Author,
+		strings.Join(rec.metadata(), ";"),
+	})
+}
+
+func (r *csvReporter) Close() error {
+	r.writer.Flush()
+	return r.writer.Error()
+}
+
+// ANSI escape sequences used by the table reporter
+const (
+	ansiReset  = "\033[0m"
+	ansiBold   = "\033[1m"
+	ansiRed    = "\033[31m"
+	ansiYellow = "\033[33m"
+	ansiCyan   = "\033[36m"
+)
+
+// tableReporter buffers findings to write them as an aligned table followed
+// by the summary totals
+type tableReporter struct {
+	w       io.Writer
+	opts    ReportOptions
+	records []findingRecord
+	summary *Summary
+}
+
+// NewTableReporter returns a reporter writing a human-readable table and the
+// totals per rule and severity once it is closed
+func NewTableReporter(w io.Writer, opts ReportOptions) Reporter {
+	return &tableReporter{w: w, opts: opts, summary: NewSummary()}
+}
+
+func (r *tableReporter) Report(f Finding) error {
+	r.records = append(r.records, newFindingRecord(f, r.opts))
+	r.summary.Add(f)
+	return nil
+}
+
+func (r *tableReporter) Close() error {
+	if len(r.records) == 0 {
+		_, err := fmt.Fprintln(r.w, "No findings")
+		return err
+	}
+
+	rows := [][]string{{"LOCATION", "RULE", "SEVERITY", "MATCH"}}
+	for _, rec := range r.records {
+		rows = append(rows, []string{
+			rec.location(),
+			rec.Rule,
+			strconv.Itoa(rec.Severity),
+			rec.Match,
+		})
+	}
+
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i, row := range rows {
+		color := ansiBold
+		if i > 0 {
+			color = severityColor(r.records[i-1].Severity)
+		}
+		for j, cell := range row {
+			if j > 0 {
+				b.WriteString("  ")
+			}
+			padded := cell + strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell))
+			if j == len(row)-1 {
+				padded = cell
+			}
+			b.WriteString(r.colorize(color, padded))
+		}
+		b.WriteString("\n")
+	}
+
+	r.writeSummary(&b)
+
+	_, err := io.WriteString(r.w, b.String())
+	return err
+}
+
+// writeSummary writes the totals per severity, highest first, and per rule
+func (r *tableReporter) writeSummary(b *strings.Builder) {
+	fmt.Fprintf(b, "\n%s\n", r.colorize(ansiBold, fmt.Sprintf("%d findings", r.summary.Total)))
+
+	severities := make([]int, 0, len(r.summary.BySeverity))
+	for severity := range r.summary.BySeverity {
+		severities = append(severities, severity)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(severities)))
+	for _, severity := range severities {
+		label := fmt.Sprintf("severity %d", severity)
+		fmt.Fprintf(b, "  %s: %d\n", r.colorize(severityColor(severity), label), r.summary.BySeverity[severity])
+	}
+
+	rules := make([]string, 0, len(r.summary.ByRule))
+	for rule := range r.summary.ByRule {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	for _, rule := range rules {
+		fmt.Fprintf(b, "  %s: %d\n", rule, r.summary.ByRule[rule])
+	}
+}
+
+func (r *tableReporter) colorize(color, s string) string {
+	if !r.opts.Color {
+		return s
+	}
+	return color + s + ansiReset
+}
+
+// severityColor returns the color of a severity, following the SARIF levels
+func severityColor(severity int) string {
+	switch SARIFLevel(severity) {
+	case "error":
+		return ansiRed
+	case "warning":
+		return ansiYellow
+	default:
+		return ansiCyan
+	}
+}
+
+// sarifReporter buffers findings to write a single SARIF log once closed
+type sarifReporter struct {
+	w        io.Writer
+	opts     ReportOptions
+	findings []Finding
+}
+
+// NewSARIFReporter returns a reporter writing a SARIF 2.1.0 log once closed
+func NewSARIFReporter(w io.Writer, opts ReportOptions) Reporter {
+	return &sarifReporter{w: w, opts: opts}
+}
+
+func (r *sarifReporter) Report(f Finding) error {
+	r.findings = append(r.findings, f)
+	return nil
+}
+
+func (r *sarifReporter) Close() error {
+	return writeSARIF(r.w, r.findings, r.opts)
+}
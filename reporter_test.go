@@ -0,0 +1,145 @@
+package leakspok
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var reporterFindings = []Finding{
+	{Rule: DefaultCPFRule, Match: "111.444.777-35", File: "users.txt", Line: 2, Column: 6},
+	{Rule: DefaultEmailRule, Match: "joao.silva@gmail.com", File: "logs/app.log", Line: 1, Column: 6},
+	{Rule: DefaultCreditCardRule, Match: "4111111111111111", File: "users.txt", Line: 9, Column: 1},
+}
+
+// report writes the findings with the reporter of the given format
+func report(t *testing.T, format string, opts ReportOptions) string {
+	t.Helper()
+
+	var out strings.Builder
+	reporter, err := NewReporter(format, &out, opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, f := range reporterFindings {
+		if err := reporter.Report(f); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if err := reporter.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return out.String()
+}
+
+func TestReportersMaskMatches(t *testing.T) {
+	for _, format := range ReportFormats() {
+		got := report(t, format, ReportOptions{})
+		for _, f := range reporterFindings {
+			if strings.Contains(got, f.Match) {
+				t.Errorf("Expected the %s report not to contain %q: %s", format, f.Match, got)
+			}
+		}
+
+		got = report(t, format, ReportOptions{ShowMatches: true})
+		for _, f := range reporterFindings {
+			if !strings.Contains(got, f.Match) {
+				t.Errorf("Expected the %s report to contain %q: %s", format, f.Match, got)
+			}
+		}
+	}
+}
+
+func TestJSONLinesReporter(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(report(t, "jsonl", ReportOptions{})), "\n")
+	if len(lines) != len(reporterFindings) {
+		t.Fatalf("Expected %d lines but got %d", len(reporterFindings), len(lines))
+	}
+
+	var rec findingRecord
+	if err := json.Unmarshal([]byte(lines[0]), &rec); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := findingRecord{
+		File:        "users.txt",
+		Line:        2,
+		Column:      6,
+		Rule:        "brazilian_CPF",
+		Description: "Brazilian CPF",
+		Severity:    3,
+		Match:       "11**********35",
+	}
+	if !reflect.DeepEqual(rec, expected) {
+		t.Errorf("Expected %+v but got %+v", expected, rec)
+	}
+}
+
+func TestCSVReporter(t *testing.T) {
+	rows, err := csv.NewReader(strings.NewReader(report(t, "csv", ReportOptions{}))).ReadAll()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(rows) != len(reporterFindings)+1 {
+		t.Fatalf("Expected a header and %d rows but got %d", len(reporterFindings), len(rows))
+	}
+
+	expected := []string{"logs/app.log", "1", "6", "email_address", "valid email address", "3", "jo****************om", "", "", ""}
+	if strings.Join(rows[2], ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %q but got %q", expected, rows[2])
+	}
+}
+
+func TestTableReporter(t *testing.T) {
+	got := report(t, "table", ReportOptions{})
+
+	expected := []string{
+		"LOCATION          RULE           SEVERITY  MATCH\n",
+		"users.txt:2:6     brazilian_CPF  3         11**********35\n",
+		"logs/app.log:1:6  email_address  3         jo****************om\n",
+		"3 findings",
+		"  severity 5: 1",
+		"  severity 3: 2",
+		"  brazilian_CPF: 1",
+		"  credit_card: 1",
+		"  email_address: 1",
+	}
+	for _, e := range expected {
+		if !strings.Contains(got, e) {
+			t.Errorf("Expected the table to contain %q:\n%s", e, got)
+		}
+	}
+	if strings.Contains(got, "\033[") {
+		t.Errorf("Expected no colors in the table:\n%s", got)
+	}
+
+	if got := report(t, "table", ReportOptions{Color: true}); !strings.Contains(got, ansiRed+"severity 5") {
+		t.Errorf("Expected a colored table:\n%s", got)
+	}
+
+	var out strings.Builder
+	reporter := NewTableReporter(&out, ReportOptions{})
+	if err := reporter.Close(); err != nil || out.String() != "No findings\n" {
+		t.Errorf("Expected an empty report but got %q (%v)", out.String(), err)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	summary := NewSummary()
+	for _, f := range reporterFindings {
+		summary.Add(f)
+	}
+
+	if summary.Total != 3 || summary.ByRule["brazilian_CPF"] != 1 || summary.BySeverity[3] != 2 || summary.BySeverity[5] != 1 {
+		t.Errorf("Unexpected summary %+v", summary)
+	}
+}
+
+func TestNewReporterUnknownFormat(t *testing.T) {
+	if _, err := NewReporter("xml", &strings.Builder{}, ReportOptions{}); err == nil {
+		t.Errorf("Expected an error for an unknown format")
+	}
+}
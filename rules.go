@@ -1,5 +1,7 @@
 package leakspok
 
+import "strings"
+
 // RuleSet creates a map of multiple rules
 type RuleSet map[string]Rule
 
@@ -9,17 +11,54 @@ type Rule struct {
 	Description      string           `json:"description,omitempty"`
 	Severity         int              `json:"severity,omitempty"`
 	Filter           Matcher          `json:"-"`
+	Locate           Locator          `json:"-"`
+	Describe         Describer        `json:"-"`
 	Anonymize        bool             `json:"redact,omitempty"`
 	AnonymizeOptions AnonymizeOptions `json:"anonymize,omitempty"`
 }
 
-// Hits enumerates all rules within a ruleset returning any matching rules
+// Describer returns details about the match at loc within s, such as the type
+// of a document, which are reported as the metadata of its finding
+type Describer func(s string, loc []int) map[string]string
+
+// Hits enumerates all rules within a ruleset returning any matching rules.
+// Rules with a Locator match only where it finds something, so the ones that
+// need context don't match bare values.
 func (r RuleSet) Hits(s string) []Rule {
 	matchedRules := []Rule{}
 	for _, rule := range r {
-		if rule.Filter(s) {
+		matched := rule.Filter
+		if rule.Locate != nil {
+			matched = func(s string) bool { return len(rule.locate(s)) > 0 }
+		}
+		if matched(s) {
 			matchedRules = append(matchedRules, rule)
 		}
 	}
 	return matchedRules
 }
+
+// locate returns the byte ranges of the matches of the rule within s. Unless
+// the rule has its own Locator, Filter is called on every field of s and the
+// punctuation surrounding the matching fields is left out.
+func (r Rule) locate(s string) [][]int {
+	if r.Locate != nil {
+		return r.Locate(s)
+	}
+
+	var locs [][]int
+	for _, loc := range fieldsIndex(s) {
+		x := s[loc[0]:loc[1]]
+		if !r.Filter(x) {
+			continue
+		}
+
+		trimmed := removePunctuation(x)
+		if trimmed == "" {
+			continue
+		}
+		start := loc[0] + strings.Index(x, trimmed)
+		locs = append(locs, []int{start, start + len(trimmed)})
+	}
+	return locs
+}
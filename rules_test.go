@@ -0,0 +1,29 @@
+package leakspok
+
+import "testing"
+
+func TestRuleSetHits(t *testing.T) {
+	tests := []struct {
+		set    RuleSet
+		input  string
+		expect []string
+	}{
+		{ColombiaRuleSet, "123456", nil},
+		{ColombiaRuleSet, "cédula 1234567890", []string{"colombia_cedula"}},
+		{VehicleRuleSet, "ISO-9001", nil},
+		{VehicleRuleSet, "placa ABC-1234", []string{"brazilian_license_plate"}},
+		{TravelRuleSet, "RFC123456", nil},
+		{TravelRuleSet, "passport FZ123456", []string{"passport_number"}},
+		{DefaultRuleSet, "111.444.777-35", []string{"brazilian_CPF"}},
+	}
+
+	for _, test := range tests {
+		var got []string
+		for _, rule := range test.set.Hits(test.input) {
+			got = append(got, rule.Name)
+		}
+		if !equalStrings(got, test.expect) {
+			t.Errorf("For input %q expected %q but got %q", test.input, test.expect, got)
+		}
+	}
+}
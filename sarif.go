@@ -0,0 +1,198 @@
+package leakspok
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolURI = "https://github.com/New-Horizons-Team/leakspok"
+)
+
+// sarifLog is the root object of a SARIF 2.1.0 file
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	Properties           sarifRuleProps     `json:"properties"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifRuleProps struct {
+	Severity int      `json:"severity"`
+	Tags     []string `json:"tags"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	RuleIndex  int               `json:"ruleIndex"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int          `json:"startLine"`
+	StartColumn int          `json:"startColumn"`
+	EndColumn   int          `json:"endColumn"`
+	Snippet     sarifMessage `json:"snippet"`
+}
+
+// SARIFLevel maps a rule severity to a SARIF result level
+func SARIFLevel(severity int) string {
+	switch {
+	case severity >= 4:
+		return "error"
+	case severity == 3:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// WriteSARIF writes the findings to w as a SARIF 2.1.0 log. Every rule with
+// a finding becomes a rule descriptor and every finding a result whose
+// snippet is masked, so the log itself does not leak the data it reports.
+func WriteSARIF(w io.Writer, findings []Finding) error {
+	return writeSARIF(w, findings, ReportOptions{})
+}
+
+// writeSARIF writes the SARIF log, masking snippets unless opts.ShowMatches is set
+func writeSARIF(w io.Writer, findings []Finding, opts ReportOptions) error {
+	ruleIndex := map[string]int{}
+	var rules []Rule
+	for _, f := range findings {
+		if _, ok := ruleIndex[f.Rule.Name]; !ok {
+			ruleIndex[f.Rule.Name] = 0
+			rules = append(rules, f.Rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Name < rules[j].Name
+	})
+
+	driver := sarifDriver{
+		Name:           "leakspok",
+		InformationURI: sarifToolURI,
+		Rules:          make([]sarifRule, 0, len(rules)),
+	}
+	for i, rule := range rules {
+		ruleIndex[rule.Name] = i
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.Name,
+			Name:                 rule.Name,
+			ShortDescription:     sarifMessage{Text: ruleDescription(rule)},
+			DefaultConfiguration: sarifConfiguration{Level: SARIFLevel(rule.Severity)},
+			Properties:           sarifRuleProps{Severity: rule.Severity, Tags: []string{"security", "privacy"}},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(findings))
+	for _, f := range findings {
+		var props map[string]string
+		if f.Commit != "" || len(f.Metadata) > 0 {
+			metadata := f.Metadata
+			if !opts.ShowMatches {
+				metadata = maskMetadata(metadata)
+			}
+			props = map[string]string{}
+			for key, value := range metadata {
+				props[key] = value
+			}
+			if f.Commit != "" {
+				props["commit"], props["author"] = f.Commit, f.Author
+			}
+		}
+		results = append(results, sarifResult{
+			RuleID:    f.Rule.Name,
+			RuleIndex: ruleIndex[f.Rule.Name],
+			Level:     SARIFLevel(f.Rule.Severity),
+			Message:   sarifMessage{Text: ruleDescription(f.Rule) + " found"},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: fileURI(f.File)},
+					Region: sarifRegion{
+						StartLine:   f.Line,
+						StartColumn: f.Column,
+						EndColumn:   f.Column + utf8.RuneCountInString(f.Match),
+						Snippet:     sarifMessage{Text: newFindingRecord(f, opts).Match},
+					},
+				},
+			}},
+			Properties: props,
+		})
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+// ruleDescription returns the description of a rule, falling back to its name
+func ruleDescription(rule Rule) string {
+	if rule.Description != "" {
+		return rule.Description
+	}
+	return rule.Name
+}
+
+// fileURI converts a file path into a relative or absolute URI reference
+func fileURI(path string) string {
+	u := url.URL{Path: filepath.ToSlash(path)}
+	return u.String()
+}
@@ -0,0 +1,81 @@
+package leakspok
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestWriteSARIF(t *testing.T) {
+	findings := []Finding{
+		{Rule: DefaultCreditCardRule, Match: "4111 1111 1111 1111", File: "logs/app log.txt", Line: 3, Column: 7},
+		{Rule: DefaultCPFRule, Match: "111.444.777-35", File: "users.txt", Line: 1, Column: 6},
+		{Rule: DefaultCPFRule, Match: "11144477735", File: "users.txt", Line: 2, Column: 1},
+	}
+
+	var out strings.Builder
+	if err := WriteSARIF(&out, findings); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Contains(out.String(), "111.444.777-35") || strings.Contains(out.String(), "4111 1111 1111 1111") {
+		t.Errorf("Expected the SARIF log not to contain the raw findings: %s", out.String())
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal([]byte(out.String()), &log); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("Expected a single SARIF 2.1.0 run but got %+v", log)
+	}
+
+	rules := log.Runs[0].Tool.Driver.Rules
+	if len(rules) != 2 || rules[0].ID != "brazilian_CPF" || rules[1].ID != "credit_card" {
+		t.Fatalf("Expected the brazilian_CPF and credit_card rules but got %+v", rules)
+	}
+	if rules[1].DefaultConfiguration.Level != "error" || rules[1].ShortDescription.Text != DefaultCreditCardRule.Description {
+		t.Errorf("Unexpected credit_card rule descriptor %+v", rules[1])
+	}
+
+	results := log.Runs[0].Results
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results but got %d", len(results))
+	}
+
+	first := results[0]
+	region := first.Locations[0].PhysicalLocation.Region
+	if first.RuleID != "credit_card" || first.RuleIndex != 1 || first.Level != "error" {
+		t.Errorf("Unexpected result %+v", first)
+	}
+	if uri := first.Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != "logs/app%20log.txt" {
+		t.Errorf("Expected the uri logs/app%%20log.txt but got %q", uri)
+	}
+	if region.StartLine != 3 || region.StartColumn != 7 || region.EndColumn != 26 || region.Snippet.Text != "41***************11" {
+		t.Errorf("Unexpected region %+v", region)
+	}
+
+	if results[1].RuleIndex != 0 || results[1].Level != "warning" {
+		t.Errorf("Unexpected result %+v", results[1])
+	}
+}
+
+func TestSARIFLevel(t *testing.T) {
+	tests := []struct {
+		severity int
+		expect   string
+	}{
+		{5, "error"},
+		{4, "error"},
+		{3, "warning"},
+		{2, "note"},
+		{0, "note"},
+	}
+
+	for _, test := range tests {
+		if got := SARIFLevel(test.severity); got != test.expect {
+			t.Errorf("For severity %d expected %q but got %q", test.severity, test.expect, got)
+		}
+	}
+}
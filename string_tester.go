@@ -1,21 +1,26 @@
 package leakspok
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // StringTesterResult must sync with the DefaultRuleSet
 // TODO: use code generation for this
 type StringTesterResult struct {
-	BrazilianCNPJ bool `json:"brazilian_CNPJ"`
-	BrazilianCPF  bool `json:"brazilian_CPF"`
-	CreditCard    bool `json:"credit_card"`
-	EmailAddress  bool `json:"email_address"`
-	IPAddress     bool `json:"ip_address"`
+	BrazilianCNPJ  bool `json:"brazilian_CNPJ"`
+	BrazilianCPF   bool `json:"brazilian_CPF"`
+	BrazilianPhone bool `json:"brazilian_phone"`
+	CreditCard     bool `json:"credit_card"`
+	EmailAddress   bool `json:"email_address"`
+	IPAddress      bool `json:"ip_address"`
 }
 
 // StringTester  defines a test harness for assessment
@@ -56,10 +61,14 @@ func (t *StringTester) Find(s []string) (StringTesterResult, error) {
 		matched := false
 
 		for _, str := range s {
-			for _, x := range strings.Fields(str) {
-				matched = rule.Filter(x)
-				if matched {
-					break
+			if rule.Locate != nil {
+				matched = len(rule.Locate(str)) > 0
+			} else {
+				for _, x := range strings.Fields(str) {
+					matched = rule.Filter(x)
+					if matched {
+						break
+					}
 				}
 			}
 			if matched {
@@ -93,20 +102,53 @@ func replaceFirstNCharsOfSubstring(original string, substring string, n int, rep
 		return original
 	}
 
-	if n > len(substring) {
-		// Limit n to the length of the substring
-		n = len(substring)
-	}
-
 	// Calculate the end index of the substring
 	endIndex := index + len(substring)
-	if n > len(substring) {
-		// Limit n to the length of the substring
-		n = len(substring)
-	}
 
 	// Concatenate the parts: before the substring, modified substring, and after the substring
-	return original[:index] + strings.Repeat(replacement, n) + original[index+n:endIndex] + original[endIndex:]
+	return original[:index] + maskFirstNChars(substring, n, replacement) + original[endIndex:]
+}
+
+// maskFirstNChars replaces the first n characters of s with a replacement string
+func maskFirstNChars(s string, n int, replacement string) string {
+	if count := utf8.RuneCountInString(s); n > count {
+		// Limit n to the length of the string
+		n = count
+	}
+
+	// Find the byte offset of the first character left unmasked
+	maskEnd := len(s)
+	i := 0
+	for offset := range s {
+		if i == n {
+			maskEnd = offset
+			break
+		}
+		i++
+	}
+
+	return strings.Repeat(replacement, n) + s[maskEnd:]
+}
+
+// replaceRanges replaces the byte ranges locs of s, as returned by a Locator,
+// with replace applied to their text. Ranges are replaced from the last one, so
+// the offsets of the others stay valid, and overlapping ones are skipped.
+func replaceRanges(s string, locs [][]int, replace func(x string) string) string {
+	sorted := make([][]int, len(locs))
+	copy(sorted, locs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0] > sorted[j][0]
+	})
+
+	next := len(s)
+	for _, loc := range sorted {
+		if loc[1] > next {
+			continue
+		}
+		s = s[:loc[0]] + replace(s[loc[0]:loc[1]]) + s[loc[1]:]
+		next = loc[0]
+	}
+	return s
 }
 
 // removePunctuation removes punctuation from a string
@@ -130,19 +172,22 @@ func removeEscapes(s string) string {
 }
 
 // customFields splits a string into fields based on custom delimiters
-//
-//gocyclo:ignore
 func customFields(s string) []string {
 	// First, remove the escape sequences so that FieldsFunc works properly
 	// Otherwise, strings like the "\n" in "\njoe@gmail.com" would be treated as
 	// literal strings instead of actual escape characters.
 	escapedString := removeEscapes(s)
 
-	return strings.FieldsFunc(escapedString, func(r rune) bool {
-		return unicode.IsSpace(r) || r == ',' || r == ';' || r == '!' || r == '?' || r == '(' || r == ')' ||
-			r == '[' || r == ']' || r == '{' || r == '}' || r == '"' || r == '\'' || r == '/' || r == '\\' ||
-			r == '\n' || r == '\t' || r == '\r'
-	})
+	return strings.FieldsFunc(escapedString, isFieldSeparator)
+}
+
+// isFieldSeparator reports whether r delimits fields within a string
+//
+//gocyclo:ignore
+func isFieldSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == ',' || r == ';' || r == '!' || r == '?' || r == '(' || r == ')' ||
+		r == '[' || r == ']' || r == '{' || r == '}' || r == '"' || r == '\'' || r == '/' || r == '\\' ||
+		r == '\n' || r == '\t' || r == '\r'
 }
 
 // AnonymizeFindings anonymizes all matches within the rules
@@ -151,6 +196,18 @@ func (t *StringTester) AnonymizeFindings(s string) (string, bool) {
 	hasFindings := false
 
 	for _, rule := range t.Rules {
+		if rule.Locate != nil {
+			// Anonymize the located ranges themselves, as the same text may
+			// also appear elsewhere without being a finding
+			if locs := rule.Locate(s); rule.Anonymize && len(locs) > 0 {
+				s = replaceRanges(s, locs, func(x string) string {
+					return anonymizeText(x, rule.AnonymizeOptions)
+				})
+				hasFindings = true
+			}
+			continue
+		}
+
 		for _, x := range customFields(s) {
 
 			matched = rule.Filter(x)
@@ -159,15 +216,7 @@ func (t *StringTester) AnonymizeFindings(s string) (string, bool) {
 				x = removePunctuation(x)
 
 				if rule.Anonymize {
-					// REDACT first
-					if rule.AnonymizeOptions.Strategy == REDACT {
-						s = strings.ReplaceAll(s, x, rule.AnonymizeOptions.AnonymizeString)
-					}
-					// MASK second
-					if rule.AnonymizeOptions.Strategy == MASK {
-						// Mask the first n characters of the substring
-						s = replaceFirstNCharsOfSubstring(s, x, rule.AnonymizeOptions.AnonymizeLength, rule.AnonymizeOptions.AnonymizeString)
-					}
+					s = anonymizeMatch(s, x, rule.AnonymizeOptions)
 					hasFindings = true
 				}
 			}
@@ -177,12 +226,183 @@ func (t *StringTester) AnonymizeFindings(s string) (string, bool) {
 	return s, hasFindings
 }
 
+// anonymizeText returns the anonymized form of the match x according to opts
+func anonymizeText(x string, opts AnonymizeOptions) string {
+	switch opts.Strategy {
+	case REDACT:
+		return opts.AnonymizeString
+	case MASK:
+		return maskFirstNChars(x, opts.AnonymizeLength, opts.AnonymizeString)
+	default:
+		return x
+	}
+}
+
+// anonymizeMatch anonymizes the match x within s according to opts
+func anonymizeMatch(s, x string, opts AnonymizeOptions) string {
+	// REDACT first
+	if opts.Strategy == REDACT {
+		s = strings.ReplaceAll(s, x, opts.AnonymizeString)
+	}
+	// MASK second
+	if opts.Strategy == MASK {
+		// Mask the first n characters of the substring
+		s = replaceFirstNCharsOfSubstring(s, x, opts.AnonymizeLength, opts.AnonymizeString)
+	}
+	return s
+}
+
+// maxStreamBlockLines bounds the lines AnonymizeStream holds for a single
+// multi-line finding. Longer blocks with a closing are anonymized as if it
+// followed the held lines, and their next lines are dropped up to it.
+const maxStreamBlockLines = 200
+
+// streamBlock describes the lines of a multi-line finding, such as an MRZ,
+// which AnonymizeStream holds until they are complete
+type streamBlock struct {
+	// continues reports whether line belongs to the block after lines
+	continues func(lines []string, line string) bool
+	// complete reports whether the lines close the block
+	complete func(lines []string) bool
+	// closing completes the held lines as a finding when the block overflows
+	// maxStreamBlockLines. Blocks without a closing are written as they are.
+	closing string
+	// tail returns what follows the end of the block within its last line
+	tail func(line string) string
+}
+
+// streamBlocks return the block started by line, if any
+var streamBlocks = []func(line string) (streamBlock, bool){
+	mrzStreamBlock,
+	privateKeyStreamBlock,
+}
+
+// AnonymizeStream copies r to w line by line, anonymizing the findings of each
+// line as AnonymizeFindings does. Lines are written as soon as they are read,
+// so it can be used as a filter on unbounded inputs such as log streams. Lines
+// that may start a multi-line finding, such as an MRZ or a private key, are
+// held until the finding is complete or the next line doesn't belong to it,
+// up to maxStreamBlockLines lines.
+func (t *StringTester) AnonymizeStream(r io.Reader, w io.Writer) error {
+	reader := bufio.NewReader(r)
+	writer := bufio.NewWriter(w)
+
+	var block streamBlock
+	var held []string
+	// skipping is set after an overflowing block was anonymized, until its end
+	var skipping bool
+
+	write := func(s string) error {
+		anonymized, _ := t.AnonymizeFindings(s)
+		_, err := writer.WriteString(anonymized)
+		return err
+	}
+
+	// release anonymizes the held lines together
+	release := func() error {
+		if held == nil {
+			return nil
+		}
+		s := strings.Join(held, "")
+		held = nil
+		return write(s)
+	}
+
+	// overflow anonymizes the held lines followed by the closing of the block.
+	// When that anonymizes the closing too, the lines up to the end of the
+	// block belong to the finding and are skipped.
+	overflow := func() error {
+		s := strings.Join(held, "")
+		held = nil
+		if block.closing == "" {
+			return write(s)
+		}
+
+		anonymized, _ := t.AnonymizeFindings(s + block.closing)
+		if strings.HasSuffix(anonymized, block.closing) {
+			_, err := writer.WriteString(strings.TrimSuffix(anonymized, block.closing))
+			return err
+		}
+		skipping = true
+		_, err := writer.WriteString(anonymized)
+		return err
+	}
+
+	consume := func(line string) error {
+		if skipping {
+			switch {
+			case block.complete([]string{line}):
+				skipping = false
+				return write(block.tail(line))
+			case block.continues(nil, line):
+				return nil
+			}
+			skipping = false
+		}
+
+		if held != nil && block.continues(held, line) {
+			held = append(held, line)
+			switch {
+			case block.complete(held):
+				return release()
+			case len(held) >= maxStreamBlockLines:
+				return overflow()
+			}
+			return nil
+		}
+		if err := release(); err != nil {
+			return err
+		}
+
+		for _, start := range streamBlocks {
+			if b, ok := start(line); ok {
+				block, held = b, []string{line}
+				return nil
+			}
+		}
+		return write(line)
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			if werr := consume(line); werr != nil {
+				return werr
+			}
+		}
+
+		if err == io.EOF {
+			if werr := release(); werr != nil {
+				return werr
+			}
+			return writer.Flush()
+		}
+		if err != nil {
+			return err
+		}
+
+		// Flush whenever the input has no more data ready, so interactive
+		// pipelines see each line without waiting for the buffer to fill up
+		if reader.Buffered() == 0 {
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // MaskFindings masks all matches within the rules
 func (t *StringTester) MaskFindings(s string) string {
 	matched := false
 	var matchedWords []string
 
 	for _, rule := range t.Rules {
+		if rule.Locate != nil {
+			s = replaceRanges(s, rule.Locate(s), func(string) string {
+				return DefaultMaskString
+			})
+			continue
+		}
 		for _, x := range strings.Fields(s) {
 			matched = rule.Filter(x)
 			if matched {
@@ -1,6 +1,21 @@
 package leakspok
 
-import "strconv"
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// stripPunctuation removes the quotes, brackets and punctuation marks that
+// JSON payloads and sentences add to values, wherever they are in the value
+var stripPunctuation = strings.NewReplacer(`"`, "", `,`, "", `[`, "", `]`, "", `{`, "", `}`, "",
+	`!`, "", `?`, "", "`", "", "'", "")
+
+// allSameDigit reports whether s repeats a single digit, as the placeholders
+// filling document fields do, such as "00000000000"
+func allSameDigit(s string) bool {
+	return s != "" && strings.Count(s, s[:1]) == len(s)
+}
 
 func sumDigit(s string, table []int) int {
 
@@ -20,3 +35,81 @@ func sumDigit(s string, table []int) int {
 
 	return sum
 }
+
+// sumAlnum is like sumDigit, but also accepts uppercase letters, valued by
+// their ASCII code minus 48 as in alphanumeric CNPJs
+func sumAlnum(s string, table []int) int {
+
+	if len(s) != len(table) {
+		return 0
+	}
+
+	sum := 0
+
+	for i, v := range table {
+		c := s[i]
+		if (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') {
+			sum += v * int(c-'0')
+		}
+	}
+
+	return sum
+}
+
+// fullMatch reports whether re matches the whole s
+func fullMatch(re *regexp.Regexp, s string) bool {
+	loc := re.FindStringIndex(s)
+	return loc != nil && loc[0] == 0 && loc[1] == len(s)
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// verhoeffD is the multiplication table of the dihedral group D5 used by the
+// Verhoeff checksum
+var verhoeffD = [10][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+// verhoeffP is the permutation table of the Verhoeff checksum, applied to each
+// digit according to its position from the right
+var verhoeffP = [8][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 8, 2, 1, 7, 6, 3, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+// verhoeff reports whether the digits of s, including its last check digit,
+// pass the Verhoeff checksum
+func verhoeff(s string) bool {
+	c := 0
+	for i := 0; i < len(s); i++ {
+		d := s[len(s)-1-i]
+		if d < '0' || d > '9' {
+			return false
+		}
+		c = verhoeffD[c][verhoeffP[i%8][d-'0']]
+	}
+	return c == 0
+}
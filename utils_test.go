@@ -34,3 +34,58 @@ func TestSumDigit(t *testing.T) {
 		}
 	}
 }
+
+func TestAllSameDigit(t *testing.T) {
+	tests := []struct {
+		s      string
+		result bool
+	}{
+		{s: "00000000000", result: true},
+		{s: "7", result: true},
+		{s: "11111111112", result: false},
+		{s: "", result: false},
+	}
+
+	for _, tt := range tests {
+		got := allSameDigit(tt.s)
+		if got != tt.result {
+			t.Errorf("Expected allSameDigit(%q) to be %v, but got %v", tt.s, tt.result, got)
+		}
+	}
+}
+
+func TestSumAlnum(t *testing.T) {
+	tests := []struct {
+		s      string
+		table  []int
+		result int
+	}{
+		{
+			s:      "123",
+			table:  []int{1, 2, 3},
+			result: 14, // digits are valued as in sumDigit
+		},
+		{
+			s:      "1AB",
+			table:  []int{1, 2, 3},
+			result: 89, // 1*1 + 17*2 + 18*3 = 1 + 34 + 54 = 89
+		},
+		{
+			s:      "12a",
+			table:  []int{1, 2, 3},
+			result: 5, // lowercase letters are ignored
+		},
+		{
+			s:      "123",
+			table:  []int{1, 2},
+			result: 0, // different lengths, so result is 0
+		},
+	}
+
+	for _, tt := range tests {
+		got := sumAlnum(tt.s, tt.table)
+		if got != tt.result {
+			t.Errorf("Expected sumAlnum(%q, %v) to be %d, but got %d", tt.s, tt.table, tt.result, got)
+		}
+	}
+}